@@ -6,12 +6,14 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/muhammadsaefulr/NimeStreamAPI/config"
 	module "github.com/muhammadsaefulr/NimeStreamAPI/internal"
 
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/middleware"
 	database "github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/persistence"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/scheduler"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
 
 	"github.com/gofiber/fiber/v2"
@@ -36,14 +38,15 @@ func main() {
 	app := setupFiberApp()
 	db := setupDatabase()
 	defer closeDatabase(db)
-	setupModule(app, db)
+	jobs := setupModule(app, db)
+	jobs.Start()
 
 	address := fmt.Sprintf("%s:%d", config.AppHost, config.AppPort)
 
 	// Start server and handle graceful shutdown
 	serverErrors := make(chan error, 1)
 	go startServer(app, address, serverErrors)
-	handleGracefulShutdown(ctx, app, serverErrors)
+	handleGracefulShutdown(ctx, app, jobs, serverErrors)
 }
 
 func setupFiberApp() *fiber.App {
@@ -66,9 +69,10 @@ func setupDatabase() *gorm.DB {
 	return db
 }
 
-func setupModule(app *fiber.App, db *gorm.DB) {
-	module.InitModule(app, db)
+func setupModule(app *fiber.App, db *gorm.DB) *scheduler.Scheduler {
+	jobs := module.InitModule(app, db)
 	app.Use(utils.NotFoundHandler)
+	return jobs
 }
 
 func startServer(app *fiber.App, address string, errs chan<- error) {
@@ -91,7 +95,7 @@ func closeDatabase(db *gorm.DB) {
 	}
 }
 
-func handleGracefulShutdown(ctx context.Context, app *fiber.App, serverErrors <-chan error) {
+func handleGracefulShutdown(ctx context.Context, app *fiber.App, jobs *scheduler.Scheduler, serverErrors <-chan error) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
@@ -107,5 +111,13 @@ func handleGracefulShutdown(ctx context.Context, app *fiber.App, serverErrors <-
 		utils.Log.Info("Server exiting due to context cancellation")
 	}
 
+	stopCtx, cancel := context.WithTimeout(context.Background(), time.Duration(config.SchedulerStopTimeout)*time.Second)
+	defer cancel()
+	if err := jobs.Stop(stopCtx); err != nil {
+		utils.Log.Warnf("Background jobs cancelled before finishing: %v", err)
+	} else {
+		utils.Log.Info("Background jobs stopped")
+	}
+
 	utils.Log.Info("Server exited")
 }
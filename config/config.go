@@ -1,36 +1,85 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
 
 	"github.com/spf13/viper"
 )
 
 var (
-	IsProd              bool
-	AppHost             string
-	AppPort             int
-	DBHost              string
-	DBUser              string
-	DBPassword          string
-	DBName              string
-	DBPort              int
-	JWTSecret           string
-	JWTAccessExp        int
-	JWTRefreshExp       int
-	JWTResetPasswordExp int
-	JWTVerifyEmailExp   int
-	SMTPHost            string
-	SMTPPort            int
-	SMTPUsername        string
-	SMTPPassword        string
-	EmailFrom           string
-	GoogleClientID      string
-	GoogleClientSecret  string
-	RedirectURL         string
+	IsProd                  bool
+	AppHost                 string
+	AppPort                 int
+	DBHost                  string
+	DBUser                  string
+	DBPassword              string
+	DBName                  string
+	DBPort                  int
+	JWTSecret               string
+	JWTAccessExp            int
+	JWTRefreshExp           int
+	JWTResetPasswordExp     int
+	JWTVerifyEmailExp       int
+	SMTPHost                string
+	SMTPPort                int
+	SMTPUsername            string
+	SMTPPassword            string
+	EmailFrom               string
+	GoogleClientID          string
+	GoogleClientSecret      string
+	RedirectURL             string
+	CacheDriver             string
+	CacheLRUSize            int
+	CacheTTLHome            int
+	CacheTTLDetail          int
+	CacheTTLEpisode         int
+	CacheTTLBatch           int
+	CacheTTLGenre           int
+	CacheTTLGenres          int
+	CacheTTLSearch          int
+	CacheTTLOngoing         int
+	CacheTTLCompleted       int
+	CacheTTLSchedule        int
+	CacheStaleTTL           int
+	CatalogSyncBatch        int
+	CatalogSyncConcurrency  int
+	CatalogStaleHours       int
+	ScrapeTimeout           int
+	ScrapeProxyURLs         []string
+	ScrapeUserAgents        []string
+	ScrapeMaxRetries        int
+	ScrapeRetryBaseMS       int
+	ScrapeMaxIdleConns      int
+	ScrapeBreakerThreshold  int
+	ScrapeBreakerCooldown   int
+	ResolverMaxConcurrent   int
+	ResolverTimeout         int
+	ResolverCacheTTL        int
+	ResolverCacheSize       int
+	StreamTokenSecret       string
+	StreamTokenTTL          int
+	StreamMaxPerUser        int
+	StreamIdle              int
+	StreamTimeout           int
+	JobHomeRefreshCron      string
+	JobOngoingRefreshCron   string
+	JobScheduleRefreshCron  string
+	JobAnimeIndexCron       string
+	JobCatalogSyncCron      string
+	JobCachePruneCron       string
+	JobHistoryPruneCron     string
+	JobHistoryRetentionDays int
+	JobWatchHistoryCron     string
+	WatchHistoryRetention   int
+	SchedulerMaxConcurrent  int
+	SchedulerJitter         int
+	SchedulerStopTimeout    int
 )
 
 func init() {
+	setDefaults()
 	loadConfig()
 
 	// server configuration
@@ -63,6 +112,118 @@ func init() {
 	GoogleClientID = viper.GetString("GOOGLE_CLIENT_ID")
 	GoogleClientSecret = viper.GetString("GOOGLE_CLIENT_SECRET")
 	RedirectURL = viper.GetString("REDIRECT_URL")
+
+	// scrape cache configuration
+	CacheDriver = viper.GetString("CACHE_DRIVER")
+	CacheLRUSize = viper.GetInt("CACHE_LRU_SIZE")
+	CacheTTLHome = viper.GetInt("CACHE_TTL_HOME_SECONDS")
+	CacheTTLDetail = viper.GetInt("CACHE_TTL_DETAIL_SECONDS")
+	CacheTTLEpisode = viper.GetInt("CACHE_TTL_EPISODE_SECONDS")
+	CacheTTLBatch = viper.GetInt("CACHE_TTL_BATCH_SECONDS")
+	CacheTTLGenre = viper.GetInt("CACHE_TTL_GENRE_SECONDS")
+	CacheTTLGenres = viper.GetInt("CACHE_TTL_GENRES_SECONDS")
+	CacheTTLSearch = viper.GetInt("CACHE_TTL_SEARCH_SECONDS")
+	CacheTTLOngoing = viper.GetInt("CACHE_TTL_ONGOING_SECONDS")
+	CacheTTLCompleted = viper.GetInt("CACHE_TTL_COMPLETED_SECONDS")
+	CacheTTLSchedule = viper.GetInt("CACHE_TTL_SCHEDULE_SECONDS")
+	CacheStaleTTL = viper.GetInt("CACHE_STALE_SECONDS")
+	CatalogSyncBatch = viper.GetInt("CATALOG_SYNC_BATCH")
+	CatalogSyncConcurrency = viper.GetInt("CATALOG_SYNC_CONCURRENCY")
+	CatalogStaleHours = viper.GetInt("CATALOG_STALE_HOURS")
+
+	// scraper configuration
+	ScrapeTimeout = viper.GetInt("SCRAPE_TIMEOUT_SECONDS")
+	ScrapeProxyURLs = splitList(viper.GetString("SCRAPE_PROXY_URLS"), ",")
+	ScrapeUserAgents = splitList(viper.GetString("SCRAPE_USER_AGENTS"), "|")
+	ScrapeMaxRetries = viper.GetInt("SCRAPE_MAX_RETRIES")
+	ScrapeRetryBaseMS = viper.GetInt("SCRAPE_RETRY_BASE_MS")
+	ScrapeMaxIdleConns = viper.GetInt("SCRAPE_MAX_IDLE_CONNS_PER_HOST")
+	ScrapeBreakerThreshold = viper.GetInt("SCRAPE_BREAKER_THRESHOLD")
+	ScrapeBreakerCooldown = viper.GetInt("SCRAPE_BREAKER_COOLDOWN_SECONDS")
+	ResolverMaxConcurrent = viper.GetInt("RESOLVER_MAX_CONCURRENT")
+	ResolverTimeout = viper.GetInt("RESOLVER_TIMEOUT_SECONDS")
+	ResolverCacheTTL = viper.GetInt("RESOLVER_CACHE_TTL_SECONDS")
+	ResolverCacheSize = viper.GetInt("RESOLVER_CACHE_SIZE")
+
+	// stream proxy configuration
+	StreamTokenSecret = viper.GetString("STREAM_TOKEN_SECRET")
+	StreamTokenTTL = viper.GetInt("STREAM_TOKEN_TTL_SECONDS")
+	StreamMaxPerUser = viper.GetInt("STREAM_MAX_PER_USER")
+	StreamIdle = viper.GetInt("STREAM_IDLE_SECONDS")
+	StreamTimeout = viper.GetInt("STREAM_TIMEOUT_SECONDS")
+
+	// background jobs configuration
+	JobHomeRefreshCron = viper.GetString("JOB_HOME_REFRESH_CRON")
+	JobOngoingRefreshCron = viper.GetString("JOB_ONGOING_REFRESH_CRON")
+	JobScheduleRefreshCron = viper.GetString("JOB_SCHEDULE_REFRESH_CRON")
+	JobAnimeIndexCron = viper.GetString("JOB_ANIME_INDEX_REFRESH_CRON")
+	JobCatalogSyncCron = viper.GetString("JOB_CATALOG_SYNC_CRON")
+	JobCachePruneCron = viper.GetString("JOB_SCRAPE_CACHE_PRUNE_CRON")
+	JobHistoryPruneCron = viper.GetString("JOB_HISTORY_PRUNE_CRON")
+	JobHistoryRetentionDays = viper.GetInt("JOB_HISTORY_RETENTION_DAYS")
+	JobWatchHistoryCron = viper.GetString("JOB_WATCH_HISTORY_PRUNE_CRON")
+	WatchHistoryRetention = viper.GetInt("WATCH_HISTORY_RETENTION_DAYS")
+	SchedulerMaxConcurrent = viper.GetInt("SCHEDULER_MAX_CONCURRENT")
+	SchedulerJitter = viper.GetInt("SCHEDULER_JITTER_SECONDS")
+	SchedulerStopTimeout = viper.GetInt("SCHEDULER_STOP_TIMEOUT_SECONDS")
+}
+
+func setDefaults() {
+	viper.SetDefault("CACHE_DRIVER", "memory")
+	viper.SetDefault("CACHE_LRU_SIZE", 1000)
+	viper.SetDefault("CACHE_TTL_HOME_SECONDS", 300)
+	viper.SetDefault("CACHE_TTL_DETAIL_SECONDS", 3600)
+	viper.SetDefault("CACHE_TTL_EPISODE_SECONDS", 1800)
+	viper.SetDefault("CACHE_TTL_BATCH_SECONDS", 3600)
+	viper.SetDefault("CACHE_TTL_GENRE_SECONDS", 900)
+	viper.SetDefault("CACHE_TTL_GENRES_SECONDS", 86400)
+	viper.SetDefault("CACHE_TTL_SEARCH_SECONDS", 300)
+	viper.SetDefault("CACHE_TTL_ONGOING_SECONDS", 300)
+	viper.SetDefault("CACHE_TTL_COMPLETED_SECONDS", 3600)
+	viper.SetDefault("CACHE_TTL_SCHEDULE_SECONDS", 1800)
+	viper.SetDefault("CACHE_STALE_SECONDS", 3600)
+	viper.SetDefault("CATALOG_SYNC_BATCH", 50)
+	viper.SetDefault("CATALOG_SYNC_CONCURRENCY", 2)
+	viper.SetDefault("CATALOG_STALE_HOURS", 6)
+	viper.SetDefault("SCRAPE_TIMEOUT_SECONDS", 30)
+	viper.SetDefault("SCRAPE_MAX_RETRIES", 2)
+	viper.SetDefault("SCRAPE_RETRY_BASE_MS", 500)
+	viper.SetDefault("SCRAPE_MAX_IDLE_CONNS_PER_HOST", 10)
+	viper.SetDefault("SCRAPE_BREAKER_THRESHOLD", 5)
+	viper.SetDefault("SCRAPE_BREAKER_COOLDOWN_SECONDS", 30)
+	viper.SetDefault("RESOLVER_MAX_CONCURRENT", 4)
+	viper.SetDefault("RESOLVER_TIMEOUT_SECONDS", 10)
+	viper.SetDefault("RESOLVER_CACHE_TTL_SECONDS", 3600)
+	viper.SetDefault("RESOLVER_CACHE_SIZE", 1000)
+	viper.SetDefault("STREAM_TOKEN_TTL_SECONDS", 21600)
+	viper.SetDefault("STREAM_MAX_PER_USER", 2)
+	viper.SetDefault("STREAM_IDLE_SECONDS", 30)
+	viper.SetDefault("STREAM_TIMEOUT_SECONDS", 15)
+	viper.SetDefault("JOB_HOME_REFRESH_CRON", "*/5 * * * *")
+	viper.SetDefault("JOB_ONGOING_REFRESH_CRON", "*/10 * * * *")
+	viper.SetDefault("JOB_SCHEDULE_REFRESH_CRON", "0 * * * *")
+	viper.SetDefault("JOB_ANIME_INDEX_REFRESH_CRON", "5 * * * *")
+	viper.SetDefault("JOB_CATALOG_SYNC_CRON", "15 * * * *")
+	viper.SetDefault("JOB_SCRAPE_CACHE_PRUNE_CRON", "20 * * * *")
+	viper.SetDefault("JOB_HISTORY_PRUNE_CRON", "30 3 * * *")
+	viper.SetDefault("JOB_HISTORY_RETENTION_DAYS", 14)
+	viper.SetDefault("JOB_WATCH_HISTORY_PRUNE_CRON", "45 3 * * *")
+	viper.SetDefault("WATCH_HISTORY_RETENTION_DAYS", 365)
+	viper.SetDefault("SCHEDULER_MAX_CONCURRENT", 2)
+	viper.SetDefault("SCHEDULER_JITTER_SECONDS", 30)
+	viper.SetDefault("SCHEDULER_STOP_TIMEOUT_SECONDS", 30)
+}
+
+// splitList splits a separated env value, dropping blank items.
+func splitList(value, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(value, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
 }
 
 func loadConfig() {
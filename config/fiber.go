@@ -8,8 +8,9 @@ import (
 )
 
 func FiberConfig() fiber.Config {
+	// No prefork: background jobs, the memory cache and stream limits all
+	// live in this one process, so requests must be served from it too.
 	return fiber.Config{
-		Prefork:       IsProd,
 		CaseSensitive: true,
 		ServerHeader:  "Fiber",
 		AppName:       "Fiber API",
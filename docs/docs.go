@@ -339,198 +339,197 @@ const docTemplate = `{
                 }
             }
         },
-        "/health-check": {
-            "get": {
-                "description": "Check the status of services and database connections",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health Check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/example.HealthCheckResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/example.HealthCheckResponseError"
-                        }
-                    }
-                }
-            }
-        },
-        "/otakudesu/": {
-            "get": {
-                "description": "Scrape and get list of anime from Otakudesu homepage.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Otakudesu"
-                ],
-                "summary": "Get homepage anime data",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/example.GetOdAnimeHomeResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/otakudesu/detail/{judul}": {
+        "/feed/releases": {
             "get": {
-                "description": "Scrape and get details and episode from Otakudesu.",
+                "description": "Get the episodes newly seen on the Otakudesu home and ongoing listings, newest first. With after or since, the events after it come oldest first instead: keep passing the seq of the last event received as after to page forward without missing any.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Otakudesu"
+                    "Feed"
                 ],
-                "summary": "Get details and episode",
+                "summary": "Get new episode releases",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 120,
+                        "description": "Only events recorded after the one with this seq, oldest first",
+                        "name": "after",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "example": "ds-future-sub-indo",
-                        "description": "Judul Anime",
-                        "name": "judul",
-                        "in": "path",
-                        "required": true
+                        "example": "2026-10-16T08:00:00Z",
+                        "description": "Only events detected after this RFC 3339 time, oldest first; ignored with after",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of events",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.GetOdAnimeEpisodeResponse"
+                            "$ref": "#/definitions/example.GetReleaseFeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
                         }
                     }
                 }
             }
         },
-        "/otakudesu/genre/{genre}/page/{page}": {
+        "/feed/releases.atom": {
             "get": {
-                "description": "Scrape and get anime by genre from Otakudesu.",
+                "description": "Atom 1.0 variant of /feed/releases for feed readers.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "Otakudesu"
+                    "Feed"
                 ],
-                "summary": "Get Anime Genre",
+                "summary": "Get new episode releases as Atom",
                 "parameters": [
                     {
-                        "type": "string",
-                        "example": "adventure",
-                        "description": "Genre Anime",
-                        "name": "genre",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "example": 120,
+                        "description": "Only events recorded after the one with this seq, oldest first",
+                        "name": "after",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "example": "0",
-                        "description": "Current Page",
-                        "name": "page",
-                        "in": "path",
-                        "required": true
+                        "example": "2026-10-16T08:00:00Z",
+                        "description": "Only events detected after this RFC 3339 time, oldest first; ignored with after",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of events",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Atom feed",
                         "schema": {
-                            "$ref": "#/definitions/example.GetOdAnimeByGenreResponse"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
                         }
                     }
                 }
             }
         },
-        "/otakudesu/play/{judul_eps}": {
+        "/feed/releases.rss": {
             "get": {
-                "description": "Scrape and get episode source video from Otakudesu.",
+                "description": "RSS 2.0 variant of /feed/releases for feed readers.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "Otakudesu"
+                    "Feed"
                 ],
-                "summary": "Get Episode Video Source",
+                "summary": "Get new episode releases as RSS",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 120,
+                        "description": "Only events recorded after the one with this seq, oldest first",
+                        "name": "after",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "example": "drstn-s4-episode-8-sub-indo",
-                        "description": "Judul Episode",
-                        "name": "judul_eps",
-                        "in": "path",
-                        "required": true
+                        "example": "2026-10-16T08:00:00Z",
+                        "description": "Only events detected after this RFC 3339 time, oldest first; ignored with after",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of events",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "RSS feed",
                         "schema": {
-                            "$ref": "#/definitions/example.GetOdAnimeEpisodeVideoResponse"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
                         }
                     }
                 }
             }
         },
-        "/otakudesu/search": {
+        "/health-check": {
             "get": {
-                "description": "Scrape and search anime by title from Otakudesu.",
+                "description": "Check the status of services, database connections and scrape upstream circuit breakers",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Otakudesu"
-                ],
-                "summary": "Search Anime",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "example": "one piece",
-                        "description": "Title of the Anime",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Health"
                 ],
+                "summary": "Health Check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.GetOdAnimeEpisodeVideoResponse"
+                            "$ref": "#/definitions/example.HealthCheckResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/example.HealthCheckResponseError"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/me/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Only admins can retrieve all users.",
+                "description": "Get the episodes the logged in user watched, most recently watched first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "History"
                 ],
-                "summary": "Get all users",
+                "summary": "Get my watch history",
                 "parameters": [
                     {
                         "type": "integer",
@@ -541,23 +540,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 10,
-                        "description": "Maximum number of users",
+                        "default": 20,
+                        "description": "Maximum number of episodes",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by name or email or role",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.GetAllUserResponse"
+                            "$ref": "#/definitions/example.GetHistoryResponse"
                         }
                     },
                     "401": {
@@ -565,12 +558,6 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/example.Unauthorized"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/example.Forbidden"
-                        }
                     }
                 }
             },
@@ -580,14 +567,14 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Only admins can create other users.",
+                "description": "Players report the position in an episode while it plays. Each episode keeps only its latest report; 90% watched counts as finished.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "History"
                 ],
-                "summary": "Create a user",
+                "summary": "Report playback progress",
                 "parameters": [
                     {
                         "description": "Request body",
@@ -595,15 +582,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_user_request.CreateUser"
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_history_request.ReportProgress"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.CreateUserResponse"
+                            "$ref": "#/definitions/example.ReportProgressResponse"
                         }
                     },
                     "401": {
@@ -612,50 +599,48 @@ const docTemplate = `{
                             "$ref": "#/definitions/example.Unauthorized"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/example.Forbidden"
-                        }
-                    },
-                    "409": {
-                        "description": "Email already taken",
+                    "404": {
+                        "description": "Episode not found",
                         "schema": {
-                            "$ref": "#/definitions/example.DuplicateEmail"
+                            "$ref": "#/definitions/example.NotFound"
                         }
                     }
                 }
-            }
-        },
-        "/users/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Logged in users can fetch only their own user information. Only admins can fetch other users.",
+                "description": "Remove the episodes last watched before the given time, or the whole history without it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "History"
                 ],
-                "summary": "Get a user",
+                "summary": "Clear my watch history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "example": "2026-09-01T00:00:00Z",
+                        "description": "Only clear episodes last watched before this RFC 3339 time",
+                        "name": "before",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.GetUserResponse"
+                            "$ref": "#/definitions/example.ClearHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
                         }
                     },
                     "401": {
@@ -663,40 +648,76 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/example.Unauthorized"
                         }
+                    }
+                }
+            }
+        },
+        "/me/history/continue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the last watched episode of each anime, most recently watched first. Resume an unfinished episode at position_seconds, or play next_episode_slug once it is finished. Anime finished up to their latest episode are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "History"
+                ],
+                "summary": "Get my continue watching list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.Forbidden"
+                            "$ref": "#/definitions/example.GetContinueWatchingResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/example.NotFound"
+                            "$ref": "#/definitions/example.Unauthorized"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/me/history/{slug}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Logged in users can delete only themselves. Only admins can delete other users.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "History"
                 ],
-                "summary": "Delete a user",
+                "summary": "Remove an episode from my watch history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User id",
-                        "name": "id",
+                        "description": "Episode slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
                     }
@@ -705,7 +726,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.DeleteUserResponse"
+                            "$ref": "#/definitions/example.DeleteHistoryEntryResponse"
                         }
                     },
                     "401": {
@@ -714,10 +735,197 @@ const docTemplate = `{
                             "$ref": "#/definitions/example.Unauthorized"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/example.Forbidden"
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/watchlist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the logged in user's watchlist, recently changed first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Get my watchlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "plan_to_watch",
+                            "watching",
+                            "completed",
+                            "dropped"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetWatchlistResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The status defaults to plan_to_watch.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Add an anime to my watchlist",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_watchlist_request.CreateWatchlist"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/example.AddWatchlistItemResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "409": {
+                        "description": "Already on the watchlist",
+                        "schema": {
+                            "$ref": "#/definitions/example.DuplicateWatchlistItem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/watchlist/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Get an anime on my watchlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Anime slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetWatchlistItemResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Remove an anime from my watchlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Anime slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.DeleteWatchlistItemResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
                         }
                     },
                     "404": {
@@ -734,19 +942,19 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Logged in users can only update their own information. Only admins can update other users.",
+                "description": "Only the fields sent are changed. A score of 0 clears it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Watchlist"
                 ],
-                "summary": "Update a user",
+                "summary": "Update an anime on my watchlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User id",
-                        "name": "id",
+                        "description": "Anime slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
                     },
@@ -756,7 +964,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_user_request.UpdateUser"
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_watchlist_request.UpdateWatchlist"
                         }
                     }
                 ],
@@ -764,7 +972,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/example.UpdateUserResponse"
+                            "$ref": "#/definitions/example.UpdateWatchlistItemResponse"
                         }
                     },
                     "401": {
@@ -773,169 +981,1792 @@ const docTemplate = `{
                             "$ref": "#/definitions/example.Unauthorized"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/example.Forbidden"
-                        }
-                    },
                     "404": {
                         "description": "Not found",
                         "schema": {
                             "$ref": "#/definitions/example.NotFound"
                         }
+                    }
+                }
+            }
+        },
+        "/otakudesu/": {
+            "get": {
+                "description": "Scrape and get list of anime from Otakudesu homepage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get homepage anime data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeHomeResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
                     },
-                    "409": {
-                        "description": "Email already taken",
+                    "502": {
+                        "description": "Anime source unavailable",
                         "schema": {
-                            "$ref": "#/definitions/example.DuplicateEmail"
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/anime-list": {
+            "get": {
+                "description": "Get the A–Z list of every anime on Otakudesu, served from a periodically refreshed index.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Anime Index",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "A",
+                        "description": "Only titles filed under this letter, or # for titles not starting with a letter",
+                        "name": "letter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeIndexResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime index is still being built",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "example.CreateUserResponse": {
+        },
+        "/otakudesu/batch/{slug}": {
+            "get": {
+                "description": "Scrape and get the full season download links of an anime from Otakudesu, per resolution and host.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Batch Downloads",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "zatsu-tabi-batch-sub-indo",
+                        "description": "Batch slug from an anime detail",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeBatchResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/completed": {
+            "get": {
+                "description": "Scrape and get finished anime series from Otakudesu, paginated across upstream pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Completed Anime",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeListResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/detail/{judul}": {
+            "get": {
+                "description": "Get the details and episodes of an anime from the catalogue, scraping Otakudesu for anime not catalogued yet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get details and episode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "ds-future-sub-indo",
+                        "description": "Judul Anime",
+                        "name": "judul",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeEpisodeResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/genre/{genre}": {
+            "get": {
+                "description": "Get anime by genre from the catalogue once it holds every Otakudesu title, otherwise scraped and paginated across upstream pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Anime Genre",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "adventure",
+                        "description": "Genre Anime",
+                        "name": "genre",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeByGenreResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/genre/{genre}/page/{page}": {
+            "get": {
+                "description": "Scrape one upstream page of anime by genre from Otakudesu, as is. Pages past the end are empty. Prefer /otakudesu/genre/{genre}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Anime Genre Page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "adventure",
+                        "description": "Genre Anime",
+                        "name": "genre",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 2,
+                        "description": "Upstream page number",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeByGenreResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/genres": {
+            "get": {
+                "description": "Scrape and get every genre listed on Otakudesu, with links to their anime lists.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdGenresResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/ongoing": {
+            "get": {
+                "description": "Scrape and get currently airing anime from Otakudesu, paginated across upstream pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Ongoing Anime",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeListResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/play/{judul_eps}": {
+            "get": {
+                "description": "Scrape and get episode source video from Otakudesu.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Episode Video Source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "drstn-s4-episode-8-sub-indo",
+                        "description": "Judul Episode",
+                        "name": "judul_eps",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeEpisodeVideoResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/play/{judul_eps}/server/{id}": {
+            "get": {
+                "description": "Resolve the player embed URL of one streaming server listed in an episode's stream_servers.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Resolve Stream Server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "drstn-s4-episode-8-sub-indo",
+                        "description": "Judul Episode",
+                        "name": "judul_eps",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "720p-1",
+                        "description": "Stream server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdStreamServerResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/schedule": {
+            "get": {
+                "description": "Scrape and get the weekly release schedule from Otakudesu, grouped by weekday.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Get Release Schedule",
+                "parameters": [
+                    {
+                        "enum": [
+                            "monday",
+                            "tuesday",
+                            "wednesday",
+                            "thursday",
+                            "friday",
+                            "saturday",
+                            "sunday"
+                        ],
+                        "type": "string",
+                        "description": "Only this weekday",
+                        "name": "day",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdScheduleResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/otakudesu/search": {
+            "get": {
+                "description": "Search anime by title in the catalogue once it holds every Otakudesu title, otherwise scraped and paginated across upstream pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Otakudesu"
+                ],
+                "summary": "Search Anime",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "one piece",
+                        "description": "Title of the Anime",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of anime",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetOdAnimeSearchResponse"
+                        },
+                        "headers": {
+                            "X-Cache": {
+                                "type": "string",
+                                "description": "HIT, STALE (served while refreshing) or MISS"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadRequest"
+                        }
+                    },
+                    "502": {
+                        "description": "Anime source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    },
+                    "503": {
+                        "description": "Anime source layout changed",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServiceUnavailable"
+                        }
+                    },
+                    "504": {
+                        "description": "Anime source timed out",
+                        "schema": {
+                            "$ref": "#/definitions/example.GatewayTimeout"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources": {
+            "get": {
+                "description": "Get the names of every registered anime source provider.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "List anime providers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetProvidersResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/{token}": {
+            "get": {
+                "description": "Proxy a direct video or HLS playlist from the \"stream\" link of an episode or batch stream. Range requests are passed on for seeking, and playlists are rewritten so their segments go through the proxy as well. Each viewer (user, or IP when not signed in) may watch a limited number of streams at once.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Stream"
+                ],
+                "summary": "Stream a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stream token from a stream link",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "bytes=0-1048575",
+                        "description": "Byte range",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Partial content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Stream not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "410": {
+                        "description": "Stream link expired",
+                        "schema": {
+                            "$ref": "#/definitions/example.StreamGone"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many concurrent streams",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyStreams"
+                        }
+                    },
+                    "502": {
+                        "description": "Stream source unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/example.BadGateway"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Only admins can retrieve all users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum number of users",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name or email or role",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetAllUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/example.Forbidden"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Only admins can create other users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a user",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_user_request.CreateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/example.CreateUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/example.Forbidden"
+                        }
+                    },
+                    "409": {
+                        "description": "Email already taken",
+                        "schema": {
+                            "$ref": "#/definitions/example.DuplicateEmail"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logged in users can fetch only their own user information. Only admins can fetch other users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/example.Forbidden"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logged in users can delete only themselves. Only admins can delete other users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.DeleteUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/example.Forbidden"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logged in users can only update their own information. Only admins can update other users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_user_request.UpdateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/example.UpdateUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/example.Forbidden"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/example.NotFound"
+                        }
+                    },
+                    "409": {
+                        "description": "Email already taken",
+                        "schema": {
+                            "$ref": "#/definitions/example.DuplicateEmail"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "example.AddWatchlistItemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 201
+                },
+                "data": {
+                    "$ref": "#/definitions/example.WatchlistItem"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Add watchlist item successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.BadGateway": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 502
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Anime source unavailable"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.BadRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad Request"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.ClearHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Clear history successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.CreateUserResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 201
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Create user successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "user": {
+                    "$ref": "#/definitions/example.User"
+                }
+            }
+        },
+        "example.DeleteHistoryEntryResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Delete history entry successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.DeleteUserResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Delete user successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.DeleteWatchlistItemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Delete watchlist item successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.DuplicateEmail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Email already taken"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.DuplicateWatchlistItem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Anime is already on the watchlist"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.FailedLogin": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invalid email or password"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.FailedResetPassword": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Password reset failed"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.FailedVerifyEmail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Verify email failed"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.Forbidden": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "message": {
+                    "type": "string",
+                    "example": "You don't have permission to access this resource"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.ForgotPasswordResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "message": {
+                    "type": "string",
+                    "example": "A password reset link has been sent to your email address."
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.GatewayTimeout": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 504
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Anime source timed out"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.GetAllUserResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/example.User"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Get all users successfully"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "example.GetContinueWatchingResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 201
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/example.HistoryEntry"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
                 },
                 "message": {
                     "type": "string",
-                    "example": "Create user successfully"
+                    "example": "Get continue watching successfully"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
                     "example": "success"
                 },
-                "user": {
-                    "$ref": "#/definitions/example.User"
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "example.DeleteUserResponse": {
+        "example.GetHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
                     "example": 200
                 },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/example.HistoryEntry"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
-                    "example": "Delete user successfully"
+                    "example": "Get history successfully"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
                     "example": "success"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "example.DuplicateEmail": {
+        "example.GetOdAnimeBatchResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 409
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeBatch"
                 },
                 "message": {
                     "type": "string",
-                    "example": "Email already taken"
+                    "example": "Success Retrieved Anime!"
                 },
                 "status": {
                     "type": "string",
-                    "example": "error"
+                    "example": "success"
                 }
             }
         },
-        "example.FailedLogin": {
+        "example.GetOdAnimeByGenreResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 401
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreAnime"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
                 },
                 "message": {
                     "type": "string",
-                    "example": "Invalid email or password"
+                    "example": "Success Retrieved Anime!"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
-                    "example": "error"
+                    "example": "success"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 60
                 }
             }
         },
-        "example.FailedResetPassword": {
+        "example.GetOdAnimeEpisodeResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 401
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.EpisodePageResult"
                 },
                 "message": {
                     "type": "string",
-                    "example": "Password reset failed"
+                    "example": "Berhasil mengambil data!"
                 },
                 "status": {
                     "type": "string",
-                    "example": "error"
+                    "example": "success"
                 }
             }
         },
-        "example.FailedVerifyEmail": {
+        "example.GetOdAnimeEpisodeVideoResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeSourceData"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "example.GetOdAnimeHomeResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 401
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeData"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Verify email failed"
+                    "example": "Berhasil mengambil data!"
                 },
                 "status": {
                     "type": "string",
-                    "example": "error"
+                    "example": "success"
                 }
             }
         },
-        "example.Forbidden": {
+        "example.GetOdAnimeIndexResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
-                    "example": 403
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeIndexEntry"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
                 },
                 "message": {
                     "type": "string",
-                    "example": "You don't have permission to access this resource"
+                    "example": "Success Retrieved Anime!"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
-                    "example": "error"
+                    "example": "success"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 143
                 }
             }
         },
-        "example.ForgotPasswordResponse": {
+        "example.GetOdAnimeListResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
                     "example": 200
                 },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeData"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
-                    "example": "A password reset link has been sent to your email address."
+                    "example": "Success Retrieved Anime!"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
                     "example": "success"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
-        "example.GetAllUserResponse": {
+        "example.GetOdAnimeSearchResponse": {
             "type": "object",
             "properties": {
                 "code": {
@@ -945,16 +2776,16 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/example.User"
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.SearchResult"
                     }
                 },
                 "limit": {
                     "type": "integer",
-                    "example": 10
+                    "example": 20
                 },
                 "message": {
                     "type": "string",
-                    "example": "Get all users successfully"
+                    "example": "Success Retrieved Anime!"
                 },
                 "page": {
                     "type": "integer",
@@ -970,28 +2801,103 @@ const docTemplate = `{
                 },
                 "total_results": {
                     "type": "integer",
-                    "example": 1
+                    "example": 2
                 }
             }
         },
-        "example.GetOdAnimeByGenreResponse": {
+        "example.GetOdGenresResponse": {
             "type": "object",
             "properties": {
                 "code": {
-                    "type": "integer"
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreInfo"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Success Retrieved Genres!"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.GetOdScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
                 },
                 "data": {
-                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreAnime"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleDay"
+                    }
                 },
                 "message": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Success Retrieved Schedule!"
                 },
                 "status": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.GetOdStreamServerResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamServer"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Success Retrieved Stream Server!"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "example.GetProvidersResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "otakudesu"
+                    ]
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Successfully Retrieved Providers!"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
                 }
             }
         },
-        "example.GetOdAnimeEpisodeResponse": {
+        "example.GetReleaseFeedResponse": {
             "type": "object",
             "properties": {
                 "code": {
@@ -999,11 +2905,14 @@ const docTemplate = `{
                     "example": 200
                 },
                 "data": {
-                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.EpisodePageResult"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ReleaseEvent"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Berhasil mengambil data!"
+                    "example": "Success Retrieved Releases!"
                 },
                 "status": {
                     "type": "string",
@@ -1011,24 +2920,27 @@ const docTemplate = `{
                 }
             }
         },
-        "example.GetOdAnimeEpisodeVideoResponse": {
+        "example.GetUserResponse": {
             "type": "object",
             "properties": {
                 "code": {
-                    "type": "integer"
-                },
-                "data": {
-                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeSourceData"
+                    "type": "integer",
+                    "example": 200
                 },
                 "message": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Get user successfully"
                 },
                 "status": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "success"
+                },
+                "user": {
+                    "$ref": "#/definitions/example.User"
                 }
             }
         },
-        "example.GetOdAnimeHomeResponse": {
+        "example.GetWatchlistItemResponse": {
             "type": "object",
             "properties": {
                 "code": {
@@ -1036,14 +2948,11 @@ const docTemplate = `{
                     "example": 200
                 },
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeData"
-                    }
+                    "$ref": "#/definitions/example.WatchlistItem"
                 },
                 "message": {
                     "type": "string",
-                    "example": "Berhasil mengambil data!"
+                    "example": "Get watchlist item successfully"
                 },
                 "status": {
                     "type": "string",
@@ -1051,23 +2960,42 @@ const docTemplate = `{
                 }
             }
         },
-        "example.GetUserResponse": {
+        "example.GetWatchlistResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer",
                     "example": 200
                 },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/example.WatchlistItem"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
-                    "example": "Get user successfully"
+                    "example": "Get watchlist successfully"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "status": {
                     "type": "string",
                     "example": "success"
                 },
-                "user": {
-                    "$ref": "#/definitions/example.User"
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_results": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
@@ -1211,6 +3139,55 @@ const docTemplate = `{
                 }
             }
         },
+        "example.HistoryEntry": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string",
+                    "example": "dr-stone-s4-sub-indo"
+                },
+                "duration_seconds": {
+                    "type": "integer",
+                    "example": 1420
+                },
+                "episode": {
+                    "type": "string",
+                    "example": "Episode 8 Subtitle Indonesia"
+                },
+                "episode_slug": {
+                    "type": "string",
+                    "example": "drstn-s4-episode-8-sub-indo"
+                },
+                "finished": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9a4c2f0e-6b1d-4e8a-b3c5-2d7f1e0a8c64"
+                },
+                "next_episode_slug": {
+                    "type": "string",
+                    "example": "drstn-s4-episode-9-sub-indo"
+                },
+                "position_seconds": {
+                    "type": "integer",
+                    "example": 754
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "otakudesu"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Dr. Stone Season 4"
+                },
+                "watched_at": {
+                    "type": "string",
+                    "example": "2026-10-17T08:00:00Z"
+                }
+            }
+        },
         "example.LoginResponse": {
             "type": "object",
             "properties": {
@@ -1316,6 +3293,26 @@ const docTemplate = `{
                 }
             }
         },
+        "example.ReportProgressResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/example.HistoryEntry"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Report progress successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
         "example.ResetPasswordResponse": {
             "type": "object",
             "properties": {
@@ -1350,6 +3347,40 @@ const docTemplate = `{
                 }
             }
         },
+        "example.ServiceUnavailable": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 503
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Anime source layout changed"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "example.StreamGone": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 410
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Stream link expired"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
         "example.TokenExpires": {
             "type": "object",
             "properties": {
@@ -1374,6 +3405,23 @@ const docTemplate = `{
                 }
             }
         },
+        "example.TooManyStreams": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 429
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Too many concurrent streams"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
         "example.Unauthorized": {
             "type": "object",
             "properties": {
@@ -1411,6 +3459,26 @@ const docTemplate = `{
                 }
             }
         },
+        "example.UpdateWatchlistItemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "data": {
+                    "$ref": "#/definitions/example.WatchlistItem"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Update watchlist item successfully"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
         "example.User": {
             "type": "object",
             "properties": {
@@ -1453,6 +3521,49 @@ const docTemplate = `{
                 }
             }
         },
+        "example.WatchlistItem": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string",
+                    "example": "dr-stone-s4-sub-indo"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-16T08:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5c7e0a52-3f0b-4d55-9f0e-8d1c2b7a6e41"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Rewatch season 3 first"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "otakudesu"
+                },
+                "score": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "plan_to_watch",
+                        "watching",
+                        "completed",
+                        "dropped"
+                    ],
+                    "example": "watching"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-10-17T08:00:00Z"
+                }
+            }
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_auth_request.ForgotPassword": {
             "type": "object",
             "required": [
@@ -1512,6 +3623,29 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_history_request.ReportProgress": {
+            "type": "object",
+            "required": [
+                "episode_slug"
+            ],
+            "properties": {
+                "duration_seconds": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1420
+                },
+                "episode_slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "drstn-s4-episode-8-sub-indo"
+                },
+                "position_seconds": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 754
+                }
+            }
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_user_request.CreateUser": {
             "type": "object",
             "required": [
@@ -1577,16 +3711,125 @@ const docTemplate = `{
                     "maxLength": 20,
                     "minLength": 8,
                     "example": "password1"
+                },
+                "role": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "enum": [
+                        "user",
+                        "admin"
+                    ],
+                    "example": "user"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_watchlist_request.CreateWatchlist": {
+            "type": "object",
+            "required": [
+                "anime_slug"
+            ],
+            "properties": {
+                "anime_slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "dr-stone-s4-sub-indo"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Rewatch season 3 first"
+                },
+                "score": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 8
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "plan_to_watch",
+                        "watching",
+                        "completed",
+                        "dropped"
+                    ],
+                    "example": "watching"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_dto_watchlist_request.UpdateWatchlist": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Better than the manga"
+                },
+                "score": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0,
+                    "example": 9
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "plan_to_watch",
+                        "watching",
+                        "completed",
+                        "dropped"
+                    ],
+                    "example": "completed"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeBatch": {
+            "type": "object",
+            "properties": {
+                "batch_slug": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.VideoSource"
+                    }
+                },
+                "streams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamSource"
+                    }
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeData": {
             "type": "object",
             "properties": {
-                "judul_path": {
+                "anime_slug": {
                     "type": "string"
                 },
+                "judul_path": {
+                    "description": "Deprecated: duplicate of anime_slug for older clients",
+                    "type": "string",
+                    "x-deprecated": true
+                },
                 "latest_ep": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeDataRaw"
+                },
+                "release_day": {
                     "type": "string"
                 },
                 "thumbnail_url": {
@@ -1595,10 +3838,18 @@ const docTemplate = `{
                 "title": {
                     "type": "string"
                 },
-                "update_anime": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeDataRaw": {
+            "type": "object",
+            "properties": {
+                "latest_ep": {
                     "type": "string"
                 },
-                "url": {
+                "update_anime": {
                     "type": "string"
                 }
             }
@@ -1606,26 +3857,40 @@ const docTemplate = `{
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeDetail": {
             "type": "object",
             "properties": {
-                "duration": {
+                "anime_slug": {
                     "type": "string"
                 },
+                "batch_slug": {
+                    "description": "set when a full season batch download exists",
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "type": "integer"
+                },
                 "genres": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreInfo"
                     }
                 },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
                 "producer": {
                     "type": "string"
                 },
                 "rating": {
-                    "type": "string"
+                    "type": "number"
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeDetailRaw"
                 },
                 "release_date": {
+                    "description": "ISO-8601 date, e.g. 2025-04-05",
                     "type": "string"
                 },
                 "status": {
-                    "type": "string"
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeStatus"
                 },
                 "studio": {
                     "type": "string"
@@ -1639,6 +3904,26 @@ const docTemplate = `{
                 "title": {
                     "type": "string"
                 },
+                "total_eps": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeDetailRaw": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "total_eps": {
                     "type": "string"
                 }
@@ -1647,6 +3932,12 @@ const docTemplate = `{
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeEpisode": {
             "type": "object",
             "properties": {
+                "episode_slug": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -1655,13 +3946,44 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeIndexEntry": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "letter": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeSourceData": {
             "type": "object",
             "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
                 "current_ep": {
                     "type": "string"
                 },
                 "download_url": {
+                    "description": "Deprecated: same as EmbedURL",
+                    "type": "string"
+                },
+                "embed_url": {
+                    "description": "player embedded by default",
+                    "type": "string"
+                },
+                "episode_slug": {
                     "type": "string"
                 },
                 "episodes": {
@@ -1670,9 +3992,18 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeEpisode"
                     }
                 },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
                 "next_ep_url": {
                     "type": "string"
                 },
+                "next_episode_slug": {
+                    "type": "string"
+                },
+                "prev_episode_slug": {
+                    "type": "string"
+                },
                 "release_date": {
                     "type": "string"
                 },
@@ -1682,11 +4013,37 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.VideoSource"
                     }
                 },
+                "stream_servers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamServer"
+                    }
+                },
+                "streams": {
+                    "description": "every mirror of Sources, best playable first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamSource"
+                    }
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeStatus": {
+            "type": "string",
+            "enum": [
+                "ongoing",
+                "completed",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "StatusOngoing",
+                "StatusCompleted",
+                "StatusUnknown"
+            ]
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.EpisodePageResult": {
             "type": "object",
             "properties": {
@@ -1704,11 +4061,20 @@ const docTemplate = `{
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreAnime": {
             "type": "object",
             "properties": {
-                "episodes": {
+                "anime_slug": {
                     "type": "string"
                 },
+                "episodes": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
                 "rating": {
-                    "type": "string"
+                    "type": "number"
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreAnimeRaw"
                 },
                 "studio": {
                     "type": "string"
@@ -1721,9 +4087,168 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreAnimeRaw": {
+            "type": "object",
+            "properties": {
+                "episodes": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreInfo": {
             "type": "object",
             "properties": {
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "string"
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ReleaseEvent": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "detected_at": {
+                    "type": "string"
+                },
+                "episode": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "seq": {
+                    "type": "integer"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ResolverStatus": {
+            "type": "string",
+            "enum": [
+                "resolved",
+                "failed",
+                "unsupported"
+            ],
+            "x-enum-comments": {
+                "ResolverFailed": "a resolver exists for the host but failed",
+                "ResolverResolved": "a resolver found the direct URL",
+                "ResolverUnsupported": "no resolver for the host, URL is as scraped"
+            },
+            "x-enum-varnames": [
+                "ResolverResolved",
+                "ResolverFailed",
+                "ResolverUnsupported"
+            ]
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleAnime": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleDay": {
+            "type": "object",
+            "properties": {
+                "anime": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleAnime"
+                    }
+                },
+                "day": {
+                    "type": "string"
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleDayRaw"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ScheduleDayRaw": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.SearchResult": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.GenreInfo"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.SearchResultRaw"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.AnimeStatus"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -1732,6 +4257,99 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.SearchResultRaw": {
+            "type": "object",
+            "properties": {
+                "rating": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamServer": {
+            "type": "object",
+            "properties": {
+                "embed_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                },
+                "quality": {
+                    "description": "e.g. \"720p\"",
+                    "type": "string"
+                },
+                "resolver_status": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ResolverStatus"
+                },
+                "server": {
+                    "description": "e.g. \"ondesu\"",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamSource": {
+            "type": "object",
+            "properties": {
+                "direct": {
+                    "type": "boolean"
+                },
+                "format": {
+                    "description": "container, e.g. \"mp4\" or \"mkv\"; \"\" when unknown",
+                    "type": "string"
+                },
+                "host": {
+                    "description": "lowercase mirror name, e.g. \"pdrain\"",
+                    "type": "string"
+                },
+                "links": {
+                    "description": "\"stream\" proxies direct URLs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.Links"
+                        }
+                    ]
+                },
+                "raw": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamSourceRaw"
+                },
+                "resolution": {
+                    "description": "vertical lines, e.g. 720",
+                    "type": "integer"
+                },
+                "resolver_status": {
+                    "$ref": "#/definitions/github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.ResolverStatus"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.StreamSourceRaw": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "quality": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_muhammadsaefulr_NimeStreamAPI_internal_domain_entity_otakudesu_scrape.VideoSource": {
             "type": "object",
             "properties": {
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type FeedController struct {
+	ReleaseFeed od_service.ReleaseFeedService
+}
+
+func NewFeedController(releaseFeed od_service.ReleaseFeedService) *FeedController {
+	return &FeedController{
+		ReleaseFeed: releaseFeed,
+	}
+}
+
+// releases reads the after/since/limit query params and loads the matching events.
+func (f *FeedController) releases(c *fiber.Ctx) ([]od_anime_entity.ReleaseEvent, error) {
+	query := &request.QueryReleaseFeed{
+		Limit: c.QueryInt("limit", 20),
+	}
+
+	if raw := c.Query("after"); raw != "" {
+		after, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "After must be a number")
+		}
+		query.After = after
+	}
+
+	if raw := c.Query("since"); raw != "" {
+		since, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Since must be an RFC 3339 time")
+		}
+		query.Since = since
+	}
+
+	events, err := f.ReleaseFeed.GetReleases(c.UserContext(), query)
+	if err != nil {
+		var validationErr validator.ValidationErrors
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error")
+	}
+
+	return events, nil
+}
+
+func sendXML(c *fiber.Ctx, contentType string, feed any) error {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Status(fiber.StatusOK).Send(append([]byte(xml.Header), body...))
+}
+
+// @Tags         Feed
+// @Summary      Get new episode releases
+// @Description  Get the episodes newly seen on the Otakudesu home and ongoing listings, newest first. With after or since, the events after it come oldest first instead: keep passing the seq of the last event received as after to page forward without missing any.
+// @Produce      json
+// @Param        after query int    false "Only events recorded after the one with this seq, oldest first" Example(120)
+// @Param        since query string false "Only events detected after this RFC 3339 time, oldest first; ignored with after" Example(2026-10-16T08:00:00Z)
+// @Param        limit query int    false "Maximum number of events" default(20)
+// @Success      200 {object} example.GetReleaseFeedResponse
+// @Router       /feed/releases [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+func (f *FeedController) GetReleases(c *fiber.Ctx) error {
+	events, err := f.releases(c)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.ReleaseEvent]{
+		Code:    fiber.StatusOK,
+		Status:  "success",
+		Message: "Success Retrieved Releases!",
+		Results: events,
+	})
+}
+
+// @Tags         Feed
+// @Summary      Get new episode releases as RSS
+// @Description  RSS 2.0 variant of /feed/releases for feed readers.
+// @Produce      xml
+// @Param        after query int    false "Only events recorded after the one with this seq, oldest first" Example(120)
+// @Param        since query string false "Only events detected after this RFC 3339 time, oldest first; ignored with after" Example(2026-10-16T08:00:00Z)
+// @Param        limit query int    false "Maximum number of events" default(20)
+// @Success      200 {string} string "RSS feed"
+// @Router       /feed/releases.rss [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+func (f *FeedController) GetReleasesRSS(c *fiber.Ctx) error {
+	events, err := f.releases(c)
+	if err != nil {
+		return err
+	}
+
+	return sendXML(c, "application/rss+xml; charset=utf-8", newRSSFeed(c.BaseURL(), c.BaseURL()+c.OriginalURL(), events))
+}
+
+// @Tags         Feed
+// @Summary      Get new episode releases as Atom
+// @Description  Atom 1.0 variant of /feed/releases for feed readers.
+// @Produce      xml
+// @Param        after query int    false "Only events recorded after the one with this seq, oldest first" Example(120)
+// @Param        since query string false "Only events detected after this RFC 3339 time, oldest first; ignored with after" Example(2026-10-16T08:00:00Z)
+// @Param        limit query int    false "Maximum number of events" default(20)
+// @Success      200 {string} string "Atom feed"
+// @Router       /feed/releases.atom [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+func (f *FeedController) GetReleasesAtom(c *fiber.Ctx) error {
+	events, err := f.releases(c)
+	if err != nil {
+		return err
+	}
+
+	return sendXML(c, "application/atom+xml; charset=utf-8", newAtomFeed(c.BaseURL(), c.BaseURL()+c.OriginalURL(), events))
+}
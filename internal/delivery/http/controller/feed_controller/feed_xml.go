@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+)
+
+const feedTitle = "NimeStream new episodes"
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Updated string   `xml:"updated"`
+	Link    atomLink `xml:"link"`
+	Summary string   `xml:"summary"`
+}
+
+func eventTitle(event od_anime_entity.ReleaseEvent) string {
+	return fmt.Sprintf("%s Episode %d", event.Title, event.Episode)
+}
+
+func eventSummary(event od_anime_entity.ReleaseEvent) string {
+	return fmt.Sprintf("Episode %d of %s is out on %s.", event.Episode, event.Title, event.Provider)
+}
+
+// eventLink points feed readers at the anime's upstream page, falling back to
+// this API's detail route.
+func eventLink(baseURL string, event od_anime_entity.ReleaseEvent) string {
+	if event.URL != "" {
+		return event.URL
+	}
+	return baseURL + event.Links["detail"]
+}
+
+// lastDetected returns when the newest event was detected; events are oldest
+// first when paged forward with since.
+func lastDetected(events []od_anime_entity.ReleaseEvent) time.Time {
+	var last time.Time
+	for _, event := range events {
+		if event.DetectedAt.After(last) {
+			last = event.DetectedAt
+		}
+	}
+	return last
+}
+
+// newRSSFeed renders events as RSS 2.0; self is the feed's own URL.
+func newRSSFeed(baseURL, self string, events []od_anime_entity.ReleaseEvent) rssFeed {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feedTitle,
+			Link:        self,
+			Description: "Episodes newly released on the anime sources tracked by NimeStream.",
+			Items:       make([]rssItem, 0, len(events)),
+		},
+	}
+	if len(events) > 0 {
+		feed.Channel.LastBuildDate = lastDetected(events).UTC().Format(time.RFC1123Z)
+	}
+
+	for _, event := range events {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       eventTitle(event),
+			Link:        eventLink(baseURL, event),
+			Description: eventSummary(event),
+			GUID:        rssGUID{Value: "urn:uuid:" + event.ID},
+			PubDate:     event.DetectedAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return feed
+}
+
+// newAtomFeed renders events as an Atom 1.0 feed; self is the feed's own URL.
+// An empty feed is dated now, since Atom requires an updated time.
+func newAtomFeed(baseURL, self string, events []od_anime_entity.ReleaseEvent) atomFeed {
+	updated := time.Now()
+	if len(events) > 0 {
+		updated = lastDetected(events)
+	}
+
+	feed := atomFeed{
+		Title:   feedTitle,
+		ID:      self,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: "NimeStream"},
+		Links:   []atomLink{{Href: self, Rel: "self"}},
+		Entries: make([]atomEntry, 0, len(events)),
+	}
+
+	for _, event := range events {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   eventTitle(event),
+			ID:      "urn:uuid:" + event.ID,
+			Updated: event.DetectedAt.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: eventLink(baseURL, event)},
+			Summary: eventSummary(event),
+		})
+	}
+
+	return feed
+}
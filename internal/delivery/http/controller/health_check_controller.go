@@ -1,9 +1,13 @@
 package controller
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
 	service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/system_service"
 )
 
@@ -36,7 +40,7 @@ func (h *HealthCheckController) addServiceStatus(
 
 // @Tags Health
 // @Summary Health Check
-// @Description Check the status of services and database connections
+// @Description Check the status of services, database connections and scrape upstream circuit breakers
 // @Accept json
 // @Produce json
 // @Success 200 {object} example.HealthCheckResponse
@@ -63,6 +67,21 @@ func (h *HealthCheckController) Check(c *fiber.Ctx) error {
 		h.addServiceStatus(&serviceList, "Memory", true, nil)
 	}
 
+	// Scrape upstreams are reported but don't fail the check, they're outside our control
+	for _, upstream := range h.HealthCheckService.UpstreamCheck() {
+		switch upstream.State {
+		case httpclient.BreakerOpen:
+			errMsg := fmt.Sprintf("circuit open after %d consecutive failures, retrying at %s",
+				upstream.Failures, upstream.RetryAt.Format(time.RFC3339))
+			h.addServiceStatus(&serviceList, upstream.Name, false, &errMsg)
+		case httpclient.BreakerHalfOpen:
+			errMsg := "circuit half-open, probing upstream"
+			h.addServiceStatus(&serviceList, upstream.Name, true, &errMsg)
+		default:
+			h.addServiceStatus(&serviceList, upstream.Name, true, nil)
+		}
+	}
+
 	// Return the response based on health check result
 	statusCode := fiber.StatusOK
 	status := "success"
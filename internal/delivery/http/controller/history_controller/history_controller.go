@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"math"
+	"time"
+
+	request "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+	user_model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/user"
+
+	history_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/history_service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type HistoryController struct {
+	HistoryService history_service.HistoryService
+}
+
+func NewHistoryController(historyService history_service.HistoryService) *HistoryController {
+	return &HistoryController{
+		HistoryService: historyService,
+	}
+}
+
+func paginated(c *fiber.Ctx, message string, query *request.QueryHistory, entries []model.HistoryEntry, totalResults int64) error {
+	return c.Status(fiber.StatusOK).
+		JSON(response.SuccessWithPaginate[model.HistoryEntry]{
+			Code:         fiber.StatusOK,
+			Status:       "success",
+			Message:      message,
+			Results:      entries,
+			Page:         query.Page,
+			Limit:        query.Limit,
+			TotalPages:   int64(math.Ceil(float64(totalResults) / float64(query.Limit))),
+			TotalResults: totalResults,
+		})
+}
+
+// @Tags         History
+// @Summary      Get my watch history
+// @Description  Get the episodes the logged in user watched, most recently watched first.
+// @Security BearerAuth
+// @Produce      json
+// @Param        page     query     int     false   "Page number"  default(1)
+// @Param        limit    query     int     false   "Maximum number of episodes"    default(20)
+// @Router       /me/history [get]
+// @Success      200  {object}  example.GetHistoryResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+func (h *HistoryController) GetHistory(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	query := &request.QueryHistory{
+		Page:  c.QueryInt("page", 1),
+		Limit: c.QueryInt("limit", 20),
+	}
+
+	entries, totalResults, err := h.HistoryService.GetHistory(c, user.ID, query)
+	if err != nil {
+		return err
+	}
+
+	return paginated(c, "Get history successfully", query, entries, totalResults)
+}
+
+// @Tags         History
+// @Summary      Get my continue watching list
+// @Description  Get the last watched episode of each anime, most recently watched first. Resume an unfinished episode at position_seconds, or play next_episode_slug once it is finished. Anime finished up to their latest episode are left out.
+// @Security BearerAuth
+// @Produce      json
+// @Param        page     query     int     false   "Page number"  default(1)
+// @Param        limit    query     int     false   "Maximum number of anime"    default(20)
+// @Router       /me/history/continue [get]
+// @Success      200  {object}  example.GetContinueWatchingResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+func (h *HistoryController) GetContinueWatching(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	query := &request.QueryHistory{
+		Page:  c.QueryInt("page", 1),
+		Limit: c.QueryInt("limit", 20),
+	}
+
+	entries, totalResults, err := h.HistoryService.GetContinueWatching(c, user.ID, query)
+	if err != nil {
+		return err
+	}
+
+	return paginated(c, "Get continue watching successfully", query, entries, totalResults)
+}
+
+// @Tags         History
+// @Summary      Report playback progress
+// @Description  Players report the position in an episode while it plays. Each episode keeps only its latest report; 90% watched counts as finished.
+// @Security BearerAuth
+// @Produce      json
+// @Param        request  body  request.ReportProgress  true  "Request body"
+// @Router       /me/history [post]
+// @Success      200  {object}  example.ReportProgressResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      404  {object}  example.NotFound  "Episode not found"
+func (h *HistoryController) ReportProgress(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+	req := new(request.ReportProgress)
+
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	entry, err := h.HistoryService.ReportProgress(c, user.ID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.SuccessWithDetail[model.HistoryEntry]{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Report progress successfully",
+			Data:    *entry,
+		})
+}
+
+// @Tags         History
+// @Summary      Remove an episode from my watch history
+// @Security BearerAuth
+// @Produce      json
+// @Param        slug  path  string  true  "Episode slug"
+// @Router       /me/history/{slug} [delete]
+// @Success      200  {object}  example.DeleteHistoryEntryResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      404  {object}  example.NotFound  "Not found"
+func (h *HistoryController) DeleteHistoryEntry(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	if err := h.HistoryService.DeleteHistoryEntry(c, user.ID, c.Params("slug")); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.Common{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Delete history entry successfully",
+		})
+}
+
+// @Tags         History
+// @Summary      Clear my watch history
+// @Description  Remove the episodes last watched before the given time, or the whole history without it.
+// @Security BearerAuth
+// @Produce      json
+// @Param        before  query  string  false  "Only clear episodes last watched before this RFC 3339 time"  Example(2026-09-01T00:00:00Z)
+// @Router       /me/history [delete]
+// @Success      200  {object}  example.ClearHistoryResponse
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+func (h *HistoryController) ClearHistory(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+	req := new(request.PruneHistory)
+
+	if raw := c.Query("before"); raw != "" {
+		before, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Before must be an RFC 3339 time")
+		}
+		req.Before = before
+	}
+
+	if _, err := h.HistoryService.PruneUserHistory(c, user.ID, req); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.Common{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Clear history successfully",
+		})
+}
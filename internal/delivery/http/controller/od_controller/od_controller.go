@@ -1,22 +1,150 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
 	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
 
 	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 )
 
 type OdAnimeController struct {
 	AnimeService od_service.AnimeService
+	AnimeIndex   od_service.AnimeIndexService
+	Providers    *od_service.ProviderRegistry
 }
 
-func NewAnimeController(animeService od_service.AnimeService) *OdAnimeController {
+func NewAnimeController(animeService od_service.AnimeService, animeIndex od_service.AnimeIndexService, providers *od_service.ProviderRegistry) *OdAnimeController {
 	return &OdAnimeController{
 		AnimeService: animeService,
+		AnimeIndex:   animeIndex,
+		Providers:    providers,
+	}
+}
+
+// service returns the AnimeService for the ":provider" route param, falling back
+// to the controller's default service on routes without one.
+func (a *OdAnimeController) service(c *fiber.Ctx) (od_service.AnimeService, error) {
+	name := c.Params("provider")
+	if name == "" {
+		return a.AnimeService, nil
+	}
+
+	svc, ok := a.Providers.Get(name)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Provider not found")
+	}
+
+	return svc, nil
+}
+
+// listQuery reads the page/limit query params of list endpoints, rejecting
+// values that aren't numbers rather than falling back to the defaults.
+func listQuery(c *fiber.Ctx) (*request.QueryAnimeList, error) {
+	query := &request.QueryAnimeList{Page: 1, Limit: 20}
+
+	if raw := c.Query("page"); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Page must be a number")
+		}
+		query.Page = page
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Limit must be a number")
+		}
+		query.Limit = limit
+	}
+
+	return query, nil
+}
+
+func paginated[T any](message string, result od_anime_entity.Paginated[T], cache *response.CacheMeta) response.SuccessWithPaginate[T] {
+	return response.SuccessWithPaginate[T]{
+		Code:         fiber.StatusOK,
+		Status:       "success",
+		Message:      message,
+		Results:      result.Items,
+		Page:         result.Page,
+		Limit:        result.Limit,
+		TotalPages:   result.TotalPages,
+		TotalResults: result.TotalResults,
+		Cache:        cache,
+	}
+}
+
+// cacheMeta sets the X-Cache and Age headers from the cache outcome recorded
+// in ctx by od_service.WithCacheMeta, and returns it for the response body.
+func cacheMeta(c *fiber.Ctx, ctx context.Context) *response.CacheMeta {
+	meta := od_service.CacheMetaOf(ctx)
+	if meta == nil {
+		return nil
+	}
+
+	c.Set("X-Cache", meta.Status)
+	if meta.Status != "MISS" {
+		c.Set(fiber.HeaderAge, strconv.FormatInt(meta.AgeSeconds, 10))
 	}
+	return meta
+}
+
+// scrapeError maps provider errors onto HTTP errors rendered by utils.ErrorHandler,
+// so clients can tell "no results" apart from a broken source.
+func scrapeError(err error) error {
+	var fiberErr *fiber.Error
+	var validationErr validator.ValidationErrors
+	if errors.As(err, &fiberErr) || errors.As(err, &validationErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, od_anime_entity.ErrTimeout):
+		return fiber.NewError(fiber.StatusGatewayTimeout, "Anime source timed out")
+	case errors.Is(err, context.Canceled):
+		return fiber.NewError(fiber.StatusRequestTimeout, "Request cancelled")
+	case errors.Is(err, od_anime_entity.ErrGenreNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Genre not found")
+	case errors.Is(err, od_anime_entity.ErrServerNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Stream server not found")
+	case errors.Is(err, od_anime_entity.ErrNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Anime not found")
+	case errors.Is(err, od_anime_entity.ErrIndexNotReady):
+		return fiber.NewError(fiber.StatusServiceUnavailable, "Anime index is still being built")
+	case errors.Is(err, od_anime_entity.ErrCircuitOpen):
+		return fiber.NewError(fiber.StatusServiceUnavailable, "Anime source temporarily unavailable")
+	case errors.Is(err, od_anime_entity.ErrLayoutChanged):
+		return fiber.NewError(fiber.StatusServiceUnavailable, "Anime source layout changed")
+	case errors.Is(err, od_anime_entity.ErrUpstreamUnreachable), errors.Is(err, od_anime_entity.ErrUpstreamStatus):
+		return fiber.NewError(fiber.StatusBadGateway, "Anime source unavailable")
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error")
+}
+
+// @Tags         Sources
+// @Summary      List anime providers
+// @Description  Get the names of every registered anime source provider.
+// @Produce      json
+// @Router       /sources [get]
+// @Success      200  {object}  example.GetProvidersResponse
+func (a *OdAnimeController) GetProviders(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[string]{
+		Code:    fiber.StatusOK,
+		Status:  "success",
+		Message: "Successfully Retrieved Providers!",
+		Results: a.Providers.Names(),
+	})
 }
 
 // @Tags         Otakudesu
@@ -25,14 +153,20 @@ func NewAnimeController(animeService od_service.AnimeService) *OdAnimeController
 // @Produce      json
 // @Router       /otakudesu/ [get]
 // @Success      200  {object}  example.GetOdAnimeHomeResponse
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
 func (a *OdAnimeController) GetHomePageAnime(c *fiber.Ctx) error {
-	animes, err := a.AnimeService.GetHomePage()
+	svc, err := a.service(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorDetails{
-			Code:    fiber.StatusInternalServerError,
-			Status:  "error",
-			Message: "Internal Server Error",
-		})
+		return err
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	animes, err := svc.GetHomePage(ctx)
+	if err != nil {
+		return scrapeError(err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.AnimeData]{
@@ -40,38 +174,46 @@ func (a *OdAnimeController) GetHomePageAnime(c *fiber.Ctx) error {
 		Status:  "success",
 		Message: "Successfully Retrieved Anime!",
 		Results: animes,
+		Cache:   cacheMeta(c, ctx),
 	})
 }
 
 // @Tags         Otakudesu
 // @Summary      Get details and episode
-// @Description  Scrape and get details and episode from Otakudesu.
+// @Description  Get the details and episodes of an anime from the catalogue, scraping Otakudesu for anime not catalogued yet.
 // @Produce      json
 // @Param        judul path      string  true   "Judul Anime" Example(ds-future-sub-indo)
 // @Success      200   {object}  example.GetOdAnimeEpisodeResponse
 // @Router       /otakudesu/detail/{judul} [get]
+// @Failure      404  {object}  example.NotFound  "Not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
 func (a *OdAnimeController) GetAnimeEpisode(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
 	judul := c.Params("judul")
-	detail, episode, err := a.AnimeService.GetAnimeEpisode(judul)
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	detail, episode, err := svc.GetAnimeEpisode(ctx, judul)
+	if err != nil {
+		return scrapeError(err)
+	}
 
 	results := od_anime_entity.EpisodePageResult{
 		AnimeDetail: detail,
 		AnimeEps:    episode,
 	}
 
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorDetails{
-			Code:    fiber.StatusInternalServerError,
-			Status:  "error",
-			Message: "Internal Server Error",
-		})
-	}
-
 	return c.Status(fiber.StatusOK).JSON(response.SuccessWithDetail[od_anime_entity.EpisodePageResult]{
 		Code:    fiber.StatusOK,
 		Status:  "success",
 		Message: "Success Retrieved Anime!",
 		Data:    results,
+		Cache:   cacheMeta(c, ctx),
 	})
 }
 
@@ -82,16 +224,23 @@ func (a *OdAnimeController) GetAnimeEpisode(c *fiber.Ctx) error {
 // @Param        judul_eps path string true "Judul Episode" Example(drstn-s4-episode-8-sub-indo)
 // @Success      200 {object} example.GetOdAnimeEpisodeVideoResponse
 // @Router       /otakudesu/play/{judul_eps} [get]
+// @Failure      404  {object}  example.NotFound  "Not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
 func (a *OdAnimeController) GetAnimeSourceVid(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
 	judul_eps := c.Params("judul_eps")
-	animSource, err := a.AnimeService.GetAnimeSourceVid(judul_eps)
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	animSource, err := svc.GetAnimeSourceVid(ctx, judul_eps)
 
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorDetails{
-			Code:    fiber.StatusInternalServerError,
-			Status:  "error",
-			Message: "Internal Server Error",
-		})
+		return scrapeError(err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(response.SuccessWithDetail[od_anime_entity.AnimeSourceData]{
@@ -99,63 +248,345 @@ func (a *OdAnimeController) GetAnimeSourceVid(c *fiber.Ctx) error {
 		Status:  "success",
 		Message: "Success Retrieved Anime",
 		Data:    animSource,
+		Cache:   cacheMeta(c, ctx),
+	})
+}
+
+// @Tags         Otakudesu
+// @Summary      Resolve Stream Server
+// @Description  Resolve the player embed URL of one streaming server listed in an episode's stream_servers.
+// @Produce      json
+// @Param        judul_eps path string true "Judul Episode" Example(drstn-s4-episode-8-sub-indo)
+// @Param        id        path string true "Stream server ID" Example(720p-1)
+// @Success      200 {object} example.GetOdStreamServerResponse
+// @Router       /otakudesu/play/{judul_eps}/server/{id} [get]
+// @Failure      404  {object}  example.NotFound  "Not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetStreamServer(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	server, err := svc.GetStreamServer(ctx, c.Params("judul_eps"), c.Params("id"))
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithDetail[od_anime_entity.StreamServer]{
+		Code:    fiber.StatusOK,
+		Status:  "success",
+		Message: "Success Retrieved Stream Server!",
+		Data:    server,
+		Cache:   cacheMeta(c, ctx),
+	})
+}
+
+// @Tags         Otakudesu
+// @Summary      Get Batch Downloads
+// @Description  Scrape and get the full season download links of an anime from Otakudesu, per resolution and host.
+// @Produce      json
+// @Param        slug path string true "Batch slug from an anime detail" Example(zatsu-tabi-batch-sub-indo)
+// @Success      200 {object} example.GetOdAnimeBatchResponse
+// @Router       /otakudesu/batch/{slug} [get]
+// @Failure      404  {object}  example.NotFound  "Not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetAnimeBatch(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	batch, err := svc.GetAnimeBatch(ctx, c.Params("slug"))
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithDetail[od_anime_entity.AnimeBatch]{
+		Code:    fiber.StatusOK,
+		Status:  "success",
+		Message: "Success Retrieved Anime!",
+		Data:    batch,
+		Cache:   cacheMeta(c, ctx),
+	})
+}
+
+// @Tags         Otakudesu
+// @Summary      Get Genres
+// @Description  Scrape and get every genre listed on Otakudesu, with links to their anime lists.
+// @Produce      json
+// @Success      200 {object} example.GetOdGenresResponse
+// @Router       /otakudesu/genres [get]
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetGenres(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	genres, err := svc.GetGenres(ctx)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.GenreInfo]{
+		Code:    fiber.StatusOK,
+		Status:  "success",
+		Message: "Success Retrieved Genres!",
+		Results: genres,
+		Cache:   cacheMeta(c, ctx),
 	})
 }
 
 // @Tags         Otakudesu
 // @Summary      Get Anime Genre
-// @Description  Scrape and get anime by genre from Otakudesu.
+// @Description  Get anime by genre from the catalogue once it holds every Otakudesu title, otherwise scraped and paginated across upstream pages.
 // @Produce      json
 // @Param        genre path string true "Genre Anime" Example(adventure)
-// @Param        page path string true "Current Page" Example(0)
+// @Param        page  query int false "Page number" default(1)
+// @Param        limit query int false "Maximum number of anime" default(20)
 // @Success      200 {object} example.GetOdAnimeByGenreResponse
-// @Router       /otakudesu/genre/{genre}/page/{page} [get]
+// @Router       /otakudesu/genre/{genre} [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      404  {object}  example.NotFound  "Genre not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
 func (a *OdAnimeController) GetAnimeGenreList(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	query, err := listQuery(c)
+	if err != nil {
+		return err
+	}
+
 	genre := c.Params("genre")
-	page := c.Params("page")
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	result, err := svc.GetAnimeGenreList(ctx, genre, query)
+
+	if err != nil {
+		return scrapeError(err)
+	}
 
-	result, err := a.AnimeService.GetAnimeGenreList(genre, page)
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, cacheMeta(c, ctx)))
+}
 
+// @Tags         Otakudesu
+// @Summary      Get Anime Genre Page
+// @Description  Scrape one upstream page of anime by genre from Otakudesu, as is. Pages past the end are empty. Prefer /otakudesu/genre/{genre}.
+// @Produce      json
+// @Param        genre path string true "Genre Anime" Example(adventure)
+// @Param        page  path int true "Upstream page number" Example(2)
+// @Success      200 {object} example.GetOdAnimeByGenreResponse
+// @Router       /otakudesu/genre/{genre}/page/{page} [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      404  {object}  example.NotFound  "Genre not found"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetAnimeGenrePage(c *fiber.Ctx) error {
+	svc, err := a.service(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorDetails{
-			Code:    fiber.StatusInternalServerError,
-			Status:  "error",
-			Message: "Internal Server Error",
-		})
+		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.GenreAnime]{
-		Code:    fiber.StatusOK,
-		Status:  "success",
-		Message: "Success Retrieved Anime!",
-		Results: result,
-	})
+	page, err := strconv.Atoi(c.Params("page"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Page must be a number")
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	result, err := svc.GetAnimeGenrePage(ctx, c.Params("genre"), page)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, cacheMeta(c, ctx)))
 }
 
 // @Tags         Otakudesu
 // @Summary      Search Anime
-// @Description  Scrape and search anime by title from Otakudesu.
+// @Description  Search anime by title in the catalogue once it holds every Otakudesu title, otherwise scraped and paginated across upstream pages.
 // @Produce      json
 // @Param        title query string true "Title of the Anime" Example(one piece)
-// @Success      200 {object} example.GetOdAnimeEpisodeVideoResponse
+// @Param        page  query int false "Page number" default(1)
+// @Param        limit query int false "Maximum number of anime" default(20)
+// @Success      200 {object} example.GetOdAnimeSearchResponse
 // @Router       /otakudesu/search [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
 func (a *OdAnimeController) GetAnimeSearchList(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	query, err := listQuery(c)
+	if err != nil {
+		return err
+	}
+
 	title := c.Query("title")
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	result, err := svc.GetAnimeByTitle(ctx, title, query)
+
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, cacheMeta(c, ctx)))
+}
+
+// @Tags         Otakudesu
+// @Summary      Get Ongoing Anime
+// @Description  Scrape and get currently airing anime from Otakudesu, paginated across upstream pages.
+// @Produce      json
+// @Param        page  query int false "Page number" default(1)
+// @Param        limit query int false "Maximum number of anime" default(20)
+// @Success      200 {object} example.GetOdAnimeListResponse
+// @Router       /otakudesu/ongoing [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetOngoingAnime(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	query, err := listQuery(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	result, err := svc.GetOngoingAnime(ctx, query)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, cacheMeta(c, ctx)))
+}
 
-	result, err := a.AnimeService.GetAnimeByTitle(title)
+// @Tags         Otakudesu
+// @Summary      Get Completed Anime
+// @Description  Scrape and get finished anime series from Otakudesu, paginated across upstream pages.
+// @Produce      json
+// @Param        page  query int false "Page number" default(1)
+// @Param        limit query int false "Maximum number of anime" default(20)
+// @Success      200 {object} example.GetOdAnimeListResponse
+// @Router       /otakudesu/completed [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetCompletedAnime(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
 
+	query, err := listQuery(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorDetails{
-			Code:    fiber.StatusInternalServerError,
-			Status:  "error",
-			Message: "Internal Server Error",
-		})
+		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.SearchResult]{
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	result, err := svc.GetCompletedAnime(ctx, query)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, cacheMeta(c, ctx)))
+}
+
+// @Tags         Otakudesu
+// @Summary      Get Release Schedule
+// @Description  Scrape and get the weekly release schedule from Otakudesu, grouped by weekday.
+// @Produce      json
+// @Param        day query string false "Only this weekday" Enums(monday, tuesday, wednesday, thursday, friday, saturday, sunday)
+// @Success      200 {object} example.GetOdScheduleResponse
+// @Router       /otakudesu/schedule [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      502  {object}  example.BadGateway  "Anime source unavailable"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime source layout changed"
+// @Failure      504  {object}  example.GatewayTimeout  "Anime source timed out"
+// @Header       200  {string}  X-Cache  "HIT, STALE (served while refreshing) or MISS"
+func (a *OdAnimeController) GetSchedule(c *fiber.Ctx) error {
+	svc, err := a.service(c)
+	if err != nil {
+		return err
+	}
+
+	query := &request.QuerySchedule{
+		Day: strings.ToLower(c.Query("day")),
+	}
+
+	ctx := od_service.WithCacheMeta(c.UserContext())
+	schedule, err := svc.GetSchedule(ctx, query)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessWithCommonData[od_anime_entity.ScheduleDay]{
 		Code:    fiber.StatusOK,
 		Status:  "success",
-		Message: "Success Retrieved Anime!",
-		Results: result,
+		Message: "Success Retrieved Schedule!",
+		Results: schedule,
+		Cache:   cacheMeta(c, ctx),
 	})
 }
+
+// @Tags         Otakudesu
+// @Summary      Get Anime Index
+// @Description  Get the A–Z list of every anime on Otakudesu, served from a periodically refreshed index.
+// @Produce      json
+// @Param        letter query string false "Only titles filed under this letter, or # for titles not starting with a letter" Example(A)
+// @Param        page   query int    false "Page number" default(1)
+// @Param        limit  query int    false "Maximum number of anime" default(20)
+// @Success      200 {object} example.GetOdAnimeIndexResponse
+// @Router       /otakudesu/anime-list [get]
+// @Failure      400  {object}  example.BadRequest  "Bad Request"
+// @Failure      503  {object}  example.ServiceUnavailable  "Anime index is still being built"
+func (a *OdAnimeController) GetAnimeIndex(c *fiber.Ctx) error {
+	list, err := listQuery(c)
+	if err != nil {
+		return err
+	}
+
+	query := &request.QueryAnimeIndex{
+		Letter: strings.ToUpper(c.Query("letter")),
+		Page:   list.Page,
+		Limit:  list.Limit,
+	}
+
+	result, err := a.AnimeIndex.GetAnimeIndex(c.UserContext(), query)
+	if err != nil {
+		return scrapeError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginated("Success Retrieved Anime!", result, nil))
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/config"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	stream_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/stream_service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type StreamController struct {
+	StreamService stream_service.StreamService
+}
+
+func NewStreamController(streamService stream_service.StreamService) *StreamController {
+	return &StreamController{
+		StreamService: streamService,
+	}
+}
+
+// viewer identifies who the concurrent-stream limit counts a request against:
+// the signed-in user when a valid access token is sent, otherwise the client
+// IP. The token is only verified, not looked up, to keep segment requests
+// off the database.
+func viewer(c *fiber.Ctx) string {
+	token := strings.TrimSpace(strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer "))
+	if token != "" {
+		if userID, err := utils.VerifyToken(token, config.JWTSecret, config.TokenTypeAccess); err == nil {
+			return "user:" + userID
+		}
+	}
+	return "ip:" + c.IP()
+}
+
+func streamError(err error) error {
+	switch {
+	case errors.Is(err, stream_service.ErrStreamNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Stream not found")
+	case errors.Is(err, stream_service.ErrStreamExpired):
+		return fiber.NewError(fiber.StatusGone, "Stream link expired")
+	case errors.Is(err, stream_service.ErrTooManyStreams):
+		return fiber.NewError(fiber.StatusTooManyRequests, "Too many concurrent streams")
+	case errors.Is(err, stream_service.ErrStreamUpstream):
+		return fiber.NewError(fiber.StatusBadGateway, "Stream source unavailable")
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error")
+}
+
+// @Tags         Stream
+// @Summary      Stream a video
+// @Description  Proxy a direct video or HLS playlist from the "stream" link of an episode or batch stream. Range requests are passed on for seeking, and playlists are rewritten so their segments go through the proxy as well. Each viewer (user, or IP when not signed in) may watch a limited number of streams at once.
+// @Produce      octet-stream
+// @Param        token path string true "Stream token from a stream link"
+// @Param        Range header string false "Byte range" Example(bytes=0-1048575)
+// @Router       /stream/{token} [get]
+// @Success      200  {file}    binary
+// @Success      206  {file}    binary  "Partial content"
+// @Failure      404  {object}  example.NotFound  "Stream not found"
+// @Failure      410  {object}  example.StreamGone  "Stream link expired"
+// @Failure      429  {object}  example.TooManyStreams  "Too many concurrent streams"
+// @Failure      502  {object}  example.BadGateway  "Stream source unavailable"
+func (s *StreamController) Stream(c *fiber.Ctx) error {
+	stream, err := s.StreamService.Open(c, c.Params("token"), viewer(c))
+	if err != nil {
+		return streamError(err)
+	}
+
+	for name := range stream.Header {
+		c.Set(name, stream.Header.Get(name))
+	}
+	// Players on other origins must be able to load the video.
+	c.Set(fiber.HeaderCrossOriginResourcePolicy, "cross-origin")
+
+	return c.Status(stream.StatusCode).SendStream(stream.Body, int(stream.Size))
+}
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"math"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
+	request "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	user_model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/user"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+
+	watchlist_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/watchlist_service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatchlistController struct {
+	WatchlistService watchlist_service.WatchlistService
+}
+
+func NewWatchlistController(watchlistService watchlist_service.WatchlistService) *WatchlistController {
+	return &WatchlistController{
+		WatchlistService: watchlistService,
+	}
+}
+
+// @Tags         Watchlist
+// @Summary      Get my watchlist
+// @Description  Get the logged in user's watchlist, recently changed first.
+// @Security BearerAuth
+// @Produce      json
+// @Param        page     query     int     false   "Page number"  default(1)
+// @Param        limit    query     int     false   "Maximum number of anime"    default(20)
+// @Param        status   query     string  false   "Filter by status"  Enums(plan_to_watch, watching, completed, dropped)
+// @Router       /me/watchlist [get]
+// @Success      200  {object}  example.GetWatchlistResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+func (w *WatchlistController) GetWatchlist(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	query := &request.QueryWatchlist{
+		Page:   c.QueryInt("page", 1),
+		Limit:  c.QueryInt("limit", 20),
+		Status: c.Query("status", ""),
+	}
+
+	items, totalResults, err := w.WatchlistService.GetWatchlist(c, user.ID, query)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.SuccessWithPaginate[model.WatchlistItem]{
+			Code:         fiber.StatusOK,
+			Status:       "success",
+			Message:      "Get watchlist successfully",
+			Results:      items,
+			Page:         query.Page,
+			Limit:        query.Limit,
+			TotalPages:   int64(math.Ceil(float64(totalResults) / float64(query.Limit))),
+			TotalResults: totalResults,
+		})
+}
+
+// @Tags         Watchlist
+// @Summary      Get an anime on my watchlist
+// @Security BearerAuth
+// @Produce      json
+// @Param        slug  path  string  true  "Anime slug"
+// @Router       /me/watchlist/{slug} [get]
+// @Success      200  {object}  example.GetWatchlistItemResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      404  {object}  example.NotFound  "Not found"
+func (w *WatchlistController) GetWatchlistItem(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	item, err := w.WatchlistService.GetWatchlistItem(c, user.ID, c.Params("slug"))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.SuccessWithDetail[model.WatchlistItem]{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Get watchlist item successfully",
+			Data:    *item,
+		})
+}
+
+// @Tags         Watchlist
+// @Summary      Add an anime to my watchlist
+// @Description  The status defaults to plan_to_watch.
+// @Security BearerAuth
+// @Produce      json
+// @Param        request  body  request.CreateWatchlist  true  "Request body"
+// @Router       /me/watchlist [post]
+// @Success      201  {object}  example.AddWatchlistItemResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      409  {object}  example.DuplicateWatchlistItem  "Already on the watchlist"
+func (w *WatchlistController) AddWatchlistItem(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+	req := new(request.CreateWatchlist)
+
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	item, err := w.WatchlistService.AddWatchlistItem(c, user.ID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).
+		JSON(response.SuccessWithDetail[model.WatchlistItem]{
+			Code:    fiber.StatusCreated,
+			Status:  "success",
+			Message: "Add watchlist item successfully",
+			Data:    *item,
+		})
+}
+
+// @Tags         Watchlist
+// @Summary      Update an anime on my watchlist
+// @Description  Only the fields sent are changed. A score of 0 clears it.
+// @Security BearerAuth
+// @Produce      json
+// @Param        slug     path  string                   true  "Anime slug"
+// @Param        request  body  request.UpdateWatchlist  true  "Request body"
+// @Router       /me/watchlist/{slug} [patch]
+// @Success      200  {object}  example.UpdateWatchlistItemResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      404  {object}  example.NotFound  "Not found"
+func (w *WatchlistController) UpdateWatchlistItem(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+	req := new(request.UpdateWatchlist)
+
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	item, err := w.WatchlistService.UpdateWatchlistItem(c, user.ID, c.Params("slug"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.SuccessWithDetail[model.WatchlistItem]{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Update watchlist item successfully",
+			Data:    *item,
+		})
+}
+
+// @Tags         Watchlist
+// @Summary      Remove an anime from my watchlist
+// @Security BearerAuth
+// @Produce      json
+// @Param        slug  path  string  true  "Anime slug"
+// @Router       /me/watchlist/{slug} [delete]
+// @Success      200  {object}  example.DeleteWatchlistItemResponse
+// @Failure      401  {object}  example.Unauthorized  "Unauthorized"
+// @Failure      404  {object}  example.NotFound  "Not found"
+func (w *WatchlistController) DeleteWatchlistItem(c *fiber.Ctx) error {
+	user, _ := c.Locals("user").(*user_model.User)
+
+	if err := w.WatchlistService.DeleteWatchlistItem(c, user.ID, c.Params("slug")); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).
+		JSON(response.Common{
+			Code:    fiber.StatusOK,
+			Status:  "success",
+			Message: "Delete watchlist item successfully",
+		})
+}
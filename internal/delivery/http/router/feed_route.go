@@ -0,0 +1,17 @@
+package router
+
+import (
+	controller "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/controller/feed_controller"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func FeedRoutes(v1 fiber.Router, r od_service.ReleaseFeedService) {
+	feedController := controller.NewFeedController(r)
+
+	feed := v1.Group("/feed")
+	feed.Get("/releases", feedController.GetReleases)
+	feed.Get("/releases.rss", feedController.GetReleasesRSS)
+	feed.Get("/releases.atom", feedController.GetReleasesAtom)
+}
@@ -0,0 +1,33 @@
+package router
+
+import (
+	history_controller "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/controller/history_controller"
+	watchlist_controller "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/controller/watchlist_controller"
+	m "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/middleware"
+
+	history_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/history_service"
+	user_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/user_service"
+	watchlist_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/watchlist_service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MeRoutes serves the logged in user's own data.
+func MeRoutes(v1 fiber.Router, u user_service.UserService, w watchlist_service.WatchlistService, h history_service.HistoryService) {
+	watchlistController := watchlist_controller.NewWatchlistController(w)
+	historyController := history_controller.NewHistoryController(h)
+
+	me := v1.Group("/me", m.Auth(u))
+
+	me.Get("/watchlist", watchlistController.GetWatchlist)
+	me.Post("/watchlist", watchlistController.AddWatchlistItem)
+	me.Get("/watchlist/:slug", watchlistController.GetWatchlistItem)
+	me.Patch("/watchlist/:slug", watchlistController.UpdateWatchlistItem)
+	me.Delete("/watchlist/:slug", watchlistController.DeleteWatchlistItem)
+
+	me.Get("/history", historyController.GetHistory)
+	me.Post("/history", historyController.ReportProgress)
+	me.Delete("/history", historyController.ClearHistory)
+	me.Get("/history/continue", historyController.GetContinueWatching)
+	me.Delete("/history/:slug", historyController.DeleteHistoryEntry)
+}
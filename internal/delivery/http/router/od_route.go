@@ -7,14 +7,34 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func OdRoutes(v1 fiber.Router, u od_service.AnimeService) {
-	odController := controller.NewAnimeController(u)
+func OdRoutes(v1 fiber.Router, u od_service.AnimeService, i od_service.AnimeIndexService, p *od_service.ProviderRegistry) {
+	odController := controller.NewAnimeController(u, i, p)
 
 	anime := v1.Group("/otakudesu")
+	anime.Get("/anime-list", odController.GetAnimeIndex)
+	animeRoutes(anime, odController)
+}
+
+func SourceRoutes(v1 fiber.Router, p *od_service.ProviderRegistry) {
+	odController := controller.NewAnimeController(nil, nil, p)
+
+	sources := v1.Group("/sources")
+	sources.Get("/", odController.GetProviders)
+
+	animeRoutes(sources.Group("/:provider"), odController)
+}
 
+func animeRoutes(anime fiber.Router, odController *controller.OdAnimeController) {
 	anime.Get("/", odController.GetHomePageAnime)
 	anime.Get("/detail/:judul", odController.GetAnimeEpisode)
 	anime.Get("/play/:judul_eps", odController.GetAnimeSourceVid)
-	anime.Get("/genre/:genre/page/:page", odController.GetAnimeGenreList)
+	anime.Get("/play/:judul_eps/server/:id", odController.GetStreamServer)
+	anime.Get("/batch/:slug", odController.GetAnimeBatch)
+	anime.Get("/genres", odController.GetGenres)
+	anime.Get("/genre/:genre", odController.GetAnimeGenreList)
+	anime.Get("/genre/:genre/page/:page", odController.GetAnimeGenrePage)
 	anime.Get("/search", odController.GetAnimeSearchList)
+	anime.Get("/ongoing", odController.GetOngoingAnime)
+	anime.Get("/completed", odController.GetCompletedAnime)
+	anime.Get("/schedule", odController.GetSchedule)
 }
@@ -0,0 +1,15 @@
+package router
+
+import (
+	controller "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/controller/stream_controller"
+	stream_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/stream_service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func StreamRoutes(v1 fiber.Router, s stream_service.StreamService) {
+	streamController := controller.NewStreamController(s)
+
+	stream := v1.Group("/stream")
+	stream.Get("/:token", streamController.Stream)
+}
@@ -0,0 +1,35 @@
+package request
+
+import "time"
+
+type QueryAnimeList struct {
+	Page  int `validate:"omitempty,min=1"`
+	Limit int `validate:"omitempty,min=1,max=50"`
+}
+
+type QuerySchedule struct {
+	Day string `validate:"omitempty,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+}
+
+type QueryAnimeIndex struct {
+	Letter string `validate:"omitempty,len=1"`
+	Page   int    `validate:"omitempty,min=1"`
+	Limit  int    `validate:"omitempty,min=1,max=100"`
+}
+
+type QueryAnimeCatalog struct {
+	Search string `validate:"omitempty,max=100"`
+	Genre  string `validate:"omitempty,max=100"`
+	Status string `validate:"omitempty,oneof=ongoing completed unknown"`
+	Sort   string `validate:"omitempty,oneof=title rating release_date updated"`
+	Page   int    `validate:"omitempty,min=1"`
+	Limit  int    `validate:"omitempty,min=1,max=100"`
+}
+
+// QueryReleaseFeed selects release events detected after Since; a zero Since
+// means all of them.
+type QueryReleaseFeed struct {
+	After int64 `validate:"omitempty,min=1"`
+	Since time.Time
+	Limit int `validate:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,22 @@
+package request
+
+import "time"
+
+// ReportProgress is sent by players while an episode plays. DurationSeconds
+// may be 0 while the player doesn't know it yet.
+type ReportProgress struct {
+	EpisodeSlug     string `json:"episode_slug" validate:"required,max=255" example:"drstn-s4-episode-8-sub-indo"`
+	PositionSeconds int    `json:"position_seconds" validate:"min=0" example:"754"`
+	DurationSeconds int    `json:"duration_seconds" validate:"min=0" example:"1420"`
+}
+
+type QueryHistory struct {
+	Page  int `validate:"omitempty,number,min=1"`
+	Limit int `validate:"omitempty,number,max=100"`
+}
+
+// PruneHistory removes the entries watched before Before, or every entry when
+// Before is zero.
+type PruneHistory struct {
+	Before time.Time
+}
@@ -1,5 +1,11 @@
 package example
 
+type BadRequest struct {
+	Code    int    `json:"code" example:"400"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Bad Request"`
+}
+
 type Unauthorized struct {
 	Code    int    `json:"code" example:"401"`
 	Status  string `json:"status" example:"error"`
@@ -41,3 +47,39 @@ type DuplicateEmail struct {
 	Status  string `json:"status" example:"error"`
 	Message string `json:"message" example:"Email already taken"`
 }
+
+type DuplicateWatchlistItem struct {
+	Code    int    `json:"code" example:"409"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Anime is already on the watchlist"`
+}
+
+type StreamGone struct {
+	Code    int    `json:"code" example:"410"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Stream link expired"`
+}
+
+type TooManyStreams struct {
+	Code    int    `json:"code" example:"429"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Too many concurrent streams"`
+}
+
+type BadGateway struct {
+	Code    int    `json:"code" example:"502"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Anime source unavailable"`
+}
+
+type ServiceUnavailable struct {
+	Code    int    `json:"code" example:"503"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Anime source layout changed"`
+}
+
+type GatewayTimeout struct {
+	Code    int    `json:"code" example:"504"`
+	Status  string `json:"status" example:"error"`
+	Message string `json:"message" example:"Anime source timed out"`
+}
@@ -100,6 +100,85 @@ type DeleteUserResponse struct {
 	Message string `json:"message" example:"Delete user successfully"`
 }
 
+type GetWatchlistResponse struct {
+	Code         int             `json:"code" example:"200"`
+	Status       string          `json:"status" example:"success"`
+	Message      string          `json:"message" example:"Get watchlist successfully"`
+	Results      []WatchlistItem `json:"data"`
+	Page         int             `json:"page" example:"1"`
+	Limit        int             `json:"limit" example:"20"`
+	TotalPages   int64           `json:"total_pages" example:"1"`
+	TotalResults int64           `json:"total_results" example:"3"`
+}
+
+type GetWatchlistItemResponse struct {
+	Code    int           `json:"code" example:"200"`
+	Status  string        `json:"status" example:"success"`
+	Message string        `json:"message" example:"Get watchlist item successfully"`
+	Data    WatchlistItem `json:"data"`
+}
+
+type AddWatchlistItemResponse struct {
+	Code    int           `json:"code" example:"201"`
+	Status  string        `json:"status" example:"success"`
+	Message string        `json:"message" example:"Add watchlist item successfully"`
+	Data    WatchlistItem `json:"data"`
+}
+
+type UpdateWatchlistItemResponse struct {
+	Code    int           `json:"code" example:"200"`
+	Status  string        `json:"status" example:"success"`
+	Message string        `json:"message" example:"Update watchlist item successfully"`
+	Data    WatchlistItem `json:"data"`
+}
+
+type DeleteWatchlistItemResponse struct {
+	Code    int    `json:"code" example:"200"`
+	Status  string `json:"status" example:"success"`
+	Message string `json:"message" example:"Delete watchlist item successfully"`
+}
+
+type GetHistoryResponse struct {
+	Code         int            `json:"code" example:"200"`
+	Status       string         `json:"status" example:"success"`
+	Message      string         `json:"message" example:"Get history successfully"`
+	Results      []HistoryEntry `json:"data"`
+	Page         int            `json:"page" example:"1"`
+	Limit        int            `json:"limit" example:"20"`
+	TotalPages   int64          `json:"total_pages" example:"1"`
+	TotalResults int64          `json:"total_results" example:"12"`
+}
+
+type GetContinueWatchingResponse struct {
+	Code         int            `json:"code" example:"200"`
+	Status       string         `json:"status" example:"success"`
+	Message      string         `json:"message" example:"Get continue watching successfully"`
+	Results      []HistoryEntry `json:"data"`
+	Page         int            `json:"page" example:"1"`
+	Limit        int            `json:"limit" example:"20"`
+	TotalPages   int64          `json:"total_pages" example:"1"`
+	TotalResults int64          `json:"total_results" example:"3"`
+}
+
+type ReportProgressResponse struct {
+	Code    int          `json:"code" example:"200"`
+	Status  string       `json:"status" example:"success"`
+	Message string       `json:"message" example:"Report progress successfully"`
+	Data    HistoryEntry `json:"data"`
+}
+
+type DeleteHistoryEntryResponse struct {
+	Code    int    `json:"code" example:"200"`
+	Status  string `json:"status" example:"success"`
+	Message string `json:"message" example:"Delete history entry successfully"`
+}
+
+type ClearHistoryResponse struct {
+	Code    int    `json:"code" example:"200"`
+	Status  string `json:"status" example:"success"`
+	Message string `json:"message" example:"Clear history successfully"`
+}
+
 type GetOdAnimeHomeResponse struct {
 	Code    int                         `json:"code" example:"200"`
 	Status  string                      `json:"status" example:"success"`
@@ -121,9 +200,88 @@ type GetOdAnimeEpisodeVideoResponse struct {
 	Result  od_anime_entity.AnimeSourceData `json:"data"`
 }
 
+type GetOdStreamServerResponse struct {
+	Code    int                          `json:"code" example:"200"`
+	Status  string                       `json:"status" example:"success"`
+	Message string                       `json:"message" example:"Success Retrieved Stream Server!"`
+	Result  od_anime_entity.StreamServer `json:"data"`
+}
+
+type GetOdGenresResponse struct {
+	Code    int                         `json:"code" example:"200"`
+	Status  string                      `json:"status" example:"success"`
+	Message string                      `json:"message" example:"Success Retrieved Genres!"`
+	Result  []od_anime_entity.GenreInfo `json:"data"`
+}
+
+type GetOdAnimeBatchResponse struct {
+	Code    int                        `json:"code" example:"200"`
+	Status  string                     `json:"status" example:"success"`
+	Message string                     `json:"message" example:"Success Retrieved Anime!"`
+	Result  od_anime_entity.AnimeBatch `json:"data"`
+}
+
 type GetOdAnimeByGenreResponse struct {
-	Code    int
-	Status  string
-	Message string
-	Result  od_anime_entity.GenreAnime `json:"data"`
+	Code         int                          `json:"code" example:"200"`
+	Status       string                       `json:"status" example:"success"`
+	Message      string                       `json:"message" example:"Success Retrieved Anime!"`
+	Results      []od_anime_entity.GenreAnime `json:"data"`
+	Page         int                          `json:"page" example:"1"`
+	Limit        int                          `json:"limit" example:"20"`
+	TotalPages   int64                        `json:"total_pages" example:"3"`
+	TotalResults int64                        `json:"total_results" example:"60"`
+}
+
+type GetOdAnimeSearchResponse struct {
+	Code         int                            `json:"code" example:"200"`
+	Status       string                         `json:"status" example:"success"`
+	Message      string                         `json:"message" example:"Success Retrieved Anime!"`
+	Results      []od_anime_entity.SearchResult `json:"data"`
+	Page         int                            `json:"page" example:"1"`
+	Limit        int                            `json:"limit" example:"20"`
+	TotalPages   int64                          `json:"total_pages" example:"1"`
+	TotalResults int64                          `json:"total_results" example:"2"`
+}
+
+type GetOdAnimeListResponse struct {
+	Code         int                         `json:"code" example:"200"`
+	Status       string                      `json:"status" example:"success"`
+	Message      string                      `json:"message" example:"Success Retrieved Anime!"`
+	Results      []od_anime_entity.AnimeData `json:"data"`
+	Page         int                         `json:"page" example:"1"`
+	Limit        int                         `json:"limit" example:"20"`
+	TotalPages   int64                       `json:"total_pages" example:"5"`
+	TotalResults int64                       `json:"total_results" example:"100"`
+}
+
+type GetOdScheduleResponse struct {
+	Code    int                           `json:"code" example:"200"`
+	Status  string                        `json:"status" example:"success"`
+	Message string                        `json:"message" example:"Success Retrieved Schedule!"`
+	Result  []od_anime_entity.ScheduleDay `json:"data"`
+}
+
+type GetOdAnimeIndexResponse struct {
+	Code         int                               `json:"code" example:"200"`
+	Status       string                            `json:"status" example:"success"`
+	Message      string                            `json:"message" example:"Success Retrieved Anime!"`
+	Results      []od_anime_entity.AnimeIndexEntry `json:"data"`
+	Page         int                               `json:"page" example:"1"`
+	Limit        int                               `json:"limit" example:"20"`
+	TotalPages   int64                             `json:"total_pages" example:"8"`
+	TotalResults int64                             `json:"total_results" example:"143"`
+}
+
+type GetReleaseFeedResponse struct {
+	Code    int                            `json:"code" example:"200"`
+	Status  string                         `json:"status" example:"success"`
+	Message string                         `json:"message" example:"Success Retrieved Releases!"`
+	Result  []od_anime_entity.ReleaseEvent `json:"data"`
+}
+
+type GetProvidersResponse struct {
+	Code    int      `json:"code" example:"200"`
+	Status  string   `json:"status" example:"success"`
+	Message string   `json:"message" example:"Successfully Retrieved Providers!"`
+	Result  []string `json:"data" example:"otakudesu"`
 }
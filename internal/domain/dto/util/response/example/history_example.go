@@ -0,0 +1,21 @@
+package example
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HistoryEntry struct {
+	ID              uuid.UUID `json:"id" example:"9a4c2f0e-6b1d-4e8a-b3c5-2d7f1e0a8c64"`
+	Provider        string    `json:"provider" example:"otakudesu"`
+	EpisodeSlug     string    `json:"episode_slug" example:"drstn-s4-episode-8-sub-indo"`
+	AnimeSlug       string    `json:"anime_slug" example:"dr-stone-s4-sub-indo"`
+	Title           string    `json:"title" example:"Dr. Stone Season 4"`
+	Episode         string    `json:"episode" example:"Episode 8 Subtitle Indonesia"`
+	NextEpisodeSlug string    `json:"next_episode_slug" example:"drstn-s4-episode-9-sub-indo"`
+	PositionSeconds int       `json:"position_seconds" example:"754"`
+	DurationSeconds int       `json:"duration_seconds" example:"1420"`
+	Finished        bool      `json:"finished" example:"false"`
+	WatchedAt       time.Time `json:"watched_at" example:"2026-10-17T08:00:00Z"`
+}
@@ -0,0 +1,18 @@
+package example
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WatchlistItem struct {
+	ID        uuid.UUID `json:"id" example:"5c7e0a52-3f0b-4d55-9f0e-8d1c2b7a6e41"`
+	Provider  string    `json:"provider" example:"otakudesu"`
+	AnimeSlug string    `json:"anime_slug" example:"dr-stone-s4-sub-indo"`
+	Status    string    `json:"status" example:"watching" enums:"plan_to_watch,watching,completed,dropped"`
+	Score     *int      `json:"score" example:"8"`
+	Notes     string    `json:"notes" example:"Rewatch season 3 first"`
+	CreatedAt time.Time `json:"created_at" example:"2026-10-16T08:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2026-10-17T08:00:00Z"`
+}
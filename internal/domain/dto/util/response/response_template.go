@@ -11,18 +11,25 @@ type Common struct {
 	Message string `json:"message"`
 }
 
+type CacheMeta struct {
+	Status     string `json:"status"`
+	AgeSeconds int64  `json:"age_seconds"`
+}
+
 type SuccessWithCommonData[T any] struct {
-	Code    int    `json:"code"`
-	Status  string `json:"status"`
-	Message string `json:"message"`
-	Results []T    `json:"data"`
+	Code    int        `json:"code"`
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+	Results []T        `json:"data"`
+	Cache   *CacheMeta `json:"cache,omitempty"`
 }
 
 type SuccessWithDetail[T any] struct {
-	Code    int    `json:"code"`
-	Status  string `json:"status"`
-	Message string `json:"message"`
-	Data    T      `json:"data"`
+	Code    int        `json:"code"`
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+	Data    T          `json:"data"`
+	Cache   *CacheMeta `json:"cache,omitempty"`
 }
 
 type SuccessWithUser struct {
@@ -41,14 +48,15 @@ type SuccessWithTokens struct {
 }
 
 type SuccessWithPaginate[T any] struct {
-	Code         int    `json:"code"`
-	Status       string `json:"status"`
-	Message      string `json:"message"`
-	Results      []T    `json:"data"`
-	Page         int    `json:"page"`
-	Limit        int    `json:"limit"`
-	TotalPages   int64  `json:"total_pages"`
-	TotalResults int64  `json:"total_results"`
+	Code         int        `json:"code"`
+	Status       string     `json:"status"`
+	Message      string     `json:"message"`
+	Results      []T        `json:"data"`
+	Page         int        `json:"page"`
+	Limit        int        `json:"limit"`
+	TotalPages   int64      `json:"total_pages"`
+	TotalResults int64      `json:"total_results"`
+	Cache        *CacheMeta `json:"cache,omitempty"`
 }
 
 type ErrorDetails struct {
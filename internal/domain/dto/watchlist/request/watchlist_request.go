@@ -0,0 +1,21 @@
+package request
+
+type CreateWatchlist struct {
+	AnimeSlug string `json:"anime_slug" validate:"required,max=255" example:"dr-stone-s4-sub-indo"`
+	Status    string `json:"status,omitempty" validate:"omitempty,oneof=plan_to_watch watching completed dropped" example:"watching"`
+	Score     *int   `json:"score,omitempty" validate:"omitempty,min=1,max=10" example:"8"`
+	Notes     string `json:"notes,omitempty" validate:"omitempty,max=1000" example:"Rewatch season 3 first"`
+}
+
+// UpdateWatchlist changes only the fields sent. A score of 0 clears it.
+type UpdateWatchlist struct {
+	Status *string `json:"status,omitempty" validate:"omitempty,oneof=plan_to_watch watching completed dropped" example:"completed"`
+	Score  *int    `json:"score,omitempty" validate:"omitempty,min=0,max=10" example:"9"`
+	Notes  *string `json:"notes,omitempty" validate:"omitempty,max=1000" example:"Better than the manga"`
+}
+
+type QueryWatchlist struct {
+	Page   int    `validate:"omitempty,number,min=1"`
+	Limit  int    `validate:"omitempty,number,max=100"`
+	Status string `validate:"omitempty,oneof=plan_to_watch watching completed dropped"`
+}
@@ -1,18 +1,53 @@
 package od_anime_entity
 
+import "time"
+
+// AnimeStatus is the normalized airing status of an anime.
+type AnimeStatus string
+
+const (
+	StatusOngoing   AnimeStatus = "ongoing"
+	StatusCompleted AnimeStatus = "completed"
+	StatusUnknown   AnimeStatus = "unknown"
+)
+
+// Links points at this API's own routes for a resource, keyed by relation
+// ("self", "detail", "play", "next", ...), so clients never need to parse
+// upstream URLs. Slugs are the path params those routes take.
+type Links map[string]string
+
+// Typed fields are normalized from the scraped text and are null when the
+// source value can't be parsed. The untouched text is kept under "raw".
+
 type AnimeData struct {
-	Title        string `json:"title"`
-	URL          string `json:"url"`
-	JudulPath    string `json:"judul_path"`
-	ThumbnailURL string `json:"thumbnail_url"`
-	LatestEp     string `json:"latest_ep"`
-	UpdateAnime  string `json:"update_anime"`
+	Title        string       `json:"title"`
+	URL          string       `json:"url"`
+	AnimeSlug    string       `json:"anime_slug"`
+	JudulPath    string       `json:"judul_path" extensions:"x-deprecated"` // Deprecated: duplicate of anime_slug for older clients
+	ThumbnailURL string       `json:"thumbnail_url"`
+	LatestEp     *int         `json:"latest_ep"`
+	ReleaseDay   string       `json:"release_day"`
+	Raw          AnimeDataRaw `json:"raw"`
+	Links        Links        `json:"links,omitempty"`
+}
+
+type AnimeDataRaw struct {
+	LatestEp    string `json:"latest_ep"`
+	UpdateAnime string `json:"update_anime"`
 }
 
 type GenreAnime struct {
-	Title    string `json:"title"`
-	URL      string `json:"url"`
-	Studio   string `json:"studio"`
+	Title     string        `json:"title"`
+	URL       string        `json:"url"`
+	AnimeSlug string        `json:"anime_slug"`
+	Studio    string        `json:"studio"`
+	Episodes  *int          `json:"episodes"`
+	Rating    *float64      `json:"rating"`
+	Raw       GenreAnimeRaw `json:"raw"`
+	Links     Links         `json:"links,omitempty"`
+}
+
+type GenreAnimeRaw struct {
 	Episodes string `json:"episodes"`
 	Rating   string `json:"rating"`
 }
@@ -20,36 +55,112 @@ type GenreAnime struct {
 type GenreInfo struct {
 	Title string `json:"title"`
 	URL   string `json:"url"`
+	Slug  string `json:"slug"`
+	Links Links  `json:"links,omitempty"`
+}
+
+// ScheduleDay groups the anime released on one weekday. Day is the lowercase
+// English weekday, or the lowercased upstream heading for groups that aren't a
+// weekday (otakudesu has a "Random" group).
+type ScheduleDay struct {
+	Day   string          `json:"day"`
+	Anime []ScheduleAnime `json:"anime"`
+	Raw   ScheduleDayRaw  `json:"raw"`
+}
+
+type ScheduleDayRaw struct {
+	Day string `json:"day"`
+}
+
+// ScheduleAnime is one entry of the release schedule. ThumbnailURL is empty
+// when the schedule page doesn't show a cover for it.
+type ScheduleAnime struct {
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	AnimeSlug    string `json:"anime_slug"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Links        Links  `json:"links,omitempty"`
+}
+
+// AnimeIndexEntry is one title of the A–Z catalogue. Letter is the uppercase
+// initial the title is filed under, or "#" for titles not starting with a letter.
+type AnimeIndexEntry struct {
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	AnimeSlug string `json:"anime_slug"`
+	Letter    string `json:"letter"`
+	Links     Links  `json:"links,omitempty"`
+}
+
+// ReleaseEvent reports an episode that newly appeared on the provider's
+// listings. Type is "episode_released"; DetectedAt is when it was first seen,
+// not when upstream published it.
+type ReleaseEvent struct {
+	ID           string    `json:"id"`
+	Seq          int64     `json:"seq"`
+	Type         string    `json:"type"`
+	Provider     string    `json:"provider"`
+	Title        string    `json:"title"`
+	URL          string    `json:"url"`
+	AnimeSlug    string    `json:"anime_slug"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	Episode      int       `json:"episode"`
+	DetectedAt   time.Time `json:"detected_at"`
+	Links        Links     `json:"links,omitempty"`
 }
 
 type SearchResult struct {
-	Title        string      `json:"title"`
-	URL          string      `json:"url"`
-	ThumbnailURL string      `json:"thumbnail_url"`
-	Genres       []GenreInfo `json:"genres"`
-	Status       string      `json:"status"`
-	Rating       string      `json:"rating"`
+	Title        string          `json:"title"`
+	URL          string          `json:"url"`
+	AnimeSlug    string          `json:"anime_slug"`
+	ThumbnailURL string          `json:"thumbnail_url"`
+	Genres       []GenreInfo     `json:"genres"`
+	Status       AnimeStatus     `json:"status"`
+	Rating       *float64        `json:"rating"`
+	Raw          SearchResultRaw `json:"raw"`
+	Links        Links           `json:"links,omitempty"`
+}
+
+type SearchResultRaw struct {
+	Status string `json:"status"`
+	Rating string `json:"rating"`
 }
 
 // Anime Episode Types
 
+// AnimeEpisode is an episode link, or a download mirror in VideoSource.DataList.
+// Mirrors point off-site and have no episode slug or links.
 type AnimeEpisode struct {
-	Title    string `json:"title"`
-	VideoURL string `json:"video_url"`
+	Title       string `json:"title"`
+	VideoURL    string `json:"video_url"`
+	EpisodeSlug string `json:"episode_slug,omitempty"`
+	Links       Links  `json:"links,omitempty"`
 }
 
 type AnimeDetail struct {
-	ThumbnailURL string      `json:"thumbnail_url"`
-	Title        string      `json:"title"`
-	Rating       string      `json:"rating"`
-	Producer     string      `json:"producer"`
-	Status       string      `json:"status"`
-	TotalEps     string      `json:"total_eps"`
-	Duration     string      `json:"duration"`
-	Studio       string      `json:"studio"`
-	ReleaseDate  string      `json:"release_date"`
-	Genres       []GenreInfo `json:"genres"`
-	Synopsis     string      `json:"synopsis"`
+	AnimeSlug       string         `json:"anime_slug"`
+	ThumbnailURL    string         `json:"thumbnail_url"`
+	Title           string         `json:"title"`
+	Rating          *float64       `json:"rating"`
+	Producer        string         `json:"producer"`
+	Status          AnimeStatus    `json:"status"`
+	TotalEps        *int           `json:"total_eps"`
+	DurationMinutes *int           `json:"duration_minutes"`
+	Studio          string         `json:"studio"`
+	ReleaseDate     *string        `json:"release_date"` // ISO-8601 date, e.g. 2025-04-05
+	Genres          []GenreInfo    `json:"genres"`
+	Synopsis        string         `json:"synopsis"`
+	BatchSlug       string         `json:"batch_slug,omitempty"` // set when a full season batch download exists
+	Raw             AnimeDetailRaw `json:"raw"`
+	Links           Links          `json:"links,omitempty"`
+}
+
+type AnimeDetailRaw struct {
+	Rating      string `json:"rating"`
+	Status      string `json:"status"`
+	TotalEps    string `json:"total_eps"`
+	Duration    string `json:"duration"`
+	ReleaseDate string `json:"release_date"`
 }
 
 type EpisodePageResult struct {
@@ -64,17 +175,80 @@ type VideoSource struct {
 	DataList []AnimeEpisode `json:"data_list"`
 }
 
+// ResolverStatus tells whether a mirror's landing page was turned into a
+// direct file URL.
+type ResolverStatus string
+
+const (
+	ResolverResolved    ResolverStatus = "resolved"    // a resolver found the direct URL
+	ResolverFailed      ResolverStatus = "failed"      // a resolver exists for the host but failed
+	ResolverUnsupported ResolverStatus = "unsupported" // no resolver for the host, URL is as scraped
+)
+
+// StreamSource is one mirror of one quality, parsed so players can pick the
+// best playable source without reading the free text in VideoSource.
+// Direct is true when URL serves the video file itself rather than a
+// host's landing page.
+type StreamSource struct {
+	Resolution     *int            `json:"resolution"` // vertical lines, e.g. 720
+	Format         string          `json:"format"`     // container, e.g. "mp4" or "mkv"; "" when unknown
+	SizeBytes      *int64          `json:"size_bytes"`
+	Host           string          `json:"host"` // lowercase mirror name, e.g. "pdrain"
+	URL            string          `json:"url"`
+	Direct         bool            `json:"direct"`
+	ResolverStatus ResolverStatus  `json:"resolver_status"`
+	Raw            StreamSourceRaw `json:"raw"`
+	Links          Links           `json:"links,omitempty"` // "stream" proxies direct URLs
+}
+
+type StreamSourceRaw struct {
+	Quality string `json:"quality"`
+	Host    string `json:"host"`
+	Size    string `json:"size"`
+	URL     string `json:"url"`
+}
+
+// StreamServer is one of the embedded players an episode page offers per
+// quality. ID identifies it within the episode, e.g. "720p-1" for the second
+// 720p server. EmbedURL is empty when the server's player couldn't be resolved.
+type StreamServer struct {
+	ID             string         `json:"id"`
+	Quality        string         `json:"quality"` // e.g. "720p"
+	Server         string         `json:"server"`  // e.g. "ondesu"
+	EmbedURL       string         `json:"embed_url"`
+	ResolverStatus ResolverStatus `json:"resolver_status"`
+	Links          Links          `json:"links,omitempty"`
+}
+
 // type SourceLink struct {
 // 	Title string `json:"title"`
 // 	URL   string `json:"url"`
 // }
 
+// AnimeBatch holds the full season downloads of an anime, grouped by
+// resolution like the per-episode AnimeSourceData.Sources.
+type AnimeBatch struct {
+	Title     string         `json:"title"`
+	BatchSlug string         `json:"batch_slug"`
+	Sources   []VideoSource  `json:"sources"`
+	Streams   []StreamSource `json:"streams"`
+	Links     Links          `json:"links,omitempty"`
+}
+
 type AnimeSourceData struct {
-	Title       string         `json:"title"`
-	ReleaseDate string         `json:"release_date"`
-	CurrentEp   string         `json:"current_ep"`
-	DownloadURL string         `json:"download_url"`
-	NextEpURL   string         `json:"next_ep_url"`
-	Sources     []VideoSource  `json:"sources"`
-	Episodes    []AnimeEpisode `json:"episodes"`
+	Title           string         `json:"title"`
+	AnimeSlug       string         `json:"anime_slug"`
+	EpisodeSlug     string         `json:"episode_slug"`
+	ReleaseDate     string         `json:"release_date"`
+	CurrentEp       string         `json:"current_ep"`
+	EmbedURL        string         `json:"embed_url"`    // player embedded by default
+	DownloadURL     string         `json:"download_url"` // Deprecated: same as EmbedURL
+	NextEpURL       string         `json:"next_ep_url"`
+	PrevEpisodeSlug string         `json:"prev_episode_slug,omitempty"`
+	NextEpisodeSlug string         `json:"next_episode_slug,omitempty"`
+	Sources         []VideoSource  `json:"sources"`
+	Streams         []StreamSource `json:"streams"` // every mirror of Sources, best playable first
+	StreamServers   []StreamServer `json:"stream_servers"`
+	Episodes        []AnimeEpisode `json:"episodes"`
+	Links           Links          `json:"links,omitempty"`
 }
@@ -0,0 +1,38 @@
+package od_anime_entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUpstreamUnreachable = errors.New("upstream unreachable")
+	ErrUpstreamStatus      = errors.New("upstream returned non-2xx status")
+	ErrTimeout             = errors.New("upstream timed out")
+	ErrLayoutChanged       = errors.New("upstream layout changed")
+	ErrNotFound            = errors.New("anime not found")
+	ErrGenreNotFound       = errors.New("genre not found")
+	ErrCircuitOpen         = errors.New("upstream circuit open")
+	ErrIndexNotReady       = errors.New("anime index not built yet")
+	ErrServerNotFound      = errors.New("stream server not found")
+)
+
+// ScrapeError describes a failed scrape. Err is one of the sentinel errors above,
+// so callers can classify it with errors.Is.
+type ScrapeError struct {
+	Op         string
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+func (e *ScrapeError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("%s %s: %v (status %d)", e.Op, e.URL, e.Err, e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Op, e.URL, e.Err)
+}
+
+func (e *ScrapeError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,19 @@
+package od_anime_entity
+
+// ListPage is one page of an upstream listing, with paging info read from
+// the upstream pager. TotalPages is 1 when the listing has no pager.
+type ListPage[T any] struct {
+	Items      []T  `json:"items"`
+	Page       int  `json:"page"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+}
+
+// Paginated is a page/limit window assembled from one or more upstream pages.
+type Paginated[T any] struct {
+	Items        []T   `json:"items"`
+	Page         int   `json:"page"`
+	Limit        int   `json:"limit"`
+	TotalPages   int64 `json:"total_pages"`
+	TotalResults int64 `json:"total_results"`
+}
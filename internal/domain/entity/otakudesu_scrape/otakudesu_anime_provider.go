@@ -0,0 +1,25 @@
+package od_anime_entity
+
+import "context"
+
+// Provider is an anime source site. Every provider returns the same entity
+// shapes so clients can switch sources without changing how they parse responses.
+// Scrapes stop when ctx is done and report ErrTimeout once its deadline passes.
+// Listing scrapes return a single upstream page, numbered from 1.
+type Provider interface {
+	Name() string
+	ScrapeHomePage(ctx context.Context) ([]AnimeData, error)
+	ScrapeAnimeDetail(ctx context.Context, judul string) (AnimeDetail, []AnimeEpisode, error)
+	ScrapeAnimeSourceData(ctx context.Context, judulEps string) (AnimeSourceData, error)
+	ScrapeAnimeBatch(ctx context.Context, batchSlug string) (AnimeBatch, error)
+	// ResolveStreamServer resolves one server of AnimeSourceData.StreamServers,
+	// reporting ErrServerNotFound when the episode has no server with that ID.
+	ResolveStreamServer(ctx context.Context, judulEps, serverID string) (StreamServer, error)
+	ScrapeGenres(ctx context.Context) ([]GenreInfo, error)
+	ScrapeGenreAnime(ctx context.Context, genre string, page int) (ListPage[GenreAnime], error)
+	ScrapeSearchAnime(ctx context.Context, title string, page int) (ListPage[SearchResult], error)
+	ScrapeOngoingAnime(ctx context.Context, page int) (ListPage[AnimeData], error)
+	ScrapeCompletedAnime(ctx context.Context, page int) (ListPage[AnimeData], error)
+	ScrapeSchedule(ctx context.Context) ([]ScheduleDay, error)
+	ScrapeAnimeIndex(ctx context.Context) ([]AnimeIndexEntry, error)
+}
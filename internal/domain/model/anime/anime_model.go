@@ -0,0 +1,69 @@
+package model
+
+import "time"
+
+// Anime is a provider's title as last synced from its detail page. Nullable
+// columns mirror the entity fields that are null when upstream text can't be
+// parsed. URL isn't stored; GetAllAnime reads it from the A–Z index.
+type Anime struct {
+	ID              string     `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	Provider        string     `gorm:"uniqueIndex:idx_animes_provider_slug;not null" json:"provider"`
+	Slug            string     `gorm:"uniqueIndex:idx_animes_provider_slug;not null" json:"slug"`
+	URL             string     `gorm:"->;-:migration" json:"url"`
+	Title           string     `gorm:"not null" json:"title"`
+	ThumbnailURL    string     `gorm:"not null" json:"thumbnail_url"`
+	Synopsis        string     `gorm:"not null" json:"synopsis"`
+	Rating          *float64   `json:"rating"`
+	Producer        string     `gorm:"not null" json:"producer"`
+	Status          string     `gorm:"not null" json:"status"`
+	TotalEps        *int       `json:"total_eps"`
+	DurationMinutes *int       `json:"duration_minutes"`
+	ReleaseDate     *time.Time `gorm:"type:date" json:"release_date"`
+	BatchSlug       string     `gorm:"not null" json:"batch_slug"`
+	SyncedAt        time.Time  `gorm:"not null" json:"synced_at"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"-"`
+	UpdatedAt       time.Time  `gorm:"autoCreateTime:milli;autoUpdateTime:milli" json:"-"`
+	Genres          []Genre    `gorm:"many2many:anime_genres" json:"genres"`
+	Studios         []Studio   `gorm:"many2many:anime_studios" json:"studios"`
+	Episodes        []Episode  `gorm:"foreignKey:AnimeID" json:"episodes,omitempty"`
+}
+
+// Episode is one entry of an anime's episode list. Position keeps the order
+// of the upstream list, which runs from the latest episode down.
+type Episode struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	AnimeID   string    `gorm:"type:uuid;not null" json:"-"`
+	Provider  string    `gorm:"uniqueIndex:idx_episodes_provider_slug;not null" json:"provider"`
+	Slug      string    `gorm:"uniqueIndex:idx_episodes_provider_slug;not null" json:"slug"`
+	Title     string    `gorm:"not null" json:"title"`
+	URL       string    `gorm:"not null" json:"url"`
+	Number    *int      `json:"number"`
+	Position  int       `gorm:"not null" json:"position"`
+	CreatedAt time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoCreateTime:milli;autoUpdateTime:milli" json:"-"`
+}
+
+// Genre is shared by every provider's anime with the same slug.
+type Genre struct {
+	ID    string `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"-"`
+	Slug  string `gorm:"uniqueIndex;not null" json:"slug"`
+	Title string `gorm:"not null" json:"title"`
+}
+
+// Studio is shared by every provider's anime with the same name.
+type Studio struct {
+	ID   string `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"-"`
+	Name string `gorm:"uniqueIndex;not null" json:"name"`
+}
+
+// AnimeGenre and AnimeStudio are the join tables behind Anime.Genres and
+// Anime.Studios.
+type AnimeGenre struct {
+	AnimeID string `gorm:"type:uuid;primaryKey"`
+	GenreID string `gorm:"type:uuid;primaryKey"`
+}
+
+type AnimeStudio struct {
+	AnimeID  string `gorm:"type:uuid;primaryKey"`
+	StudioID string `gorm:"type:uuid;primaryKey"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// AnimeIndex is one title of a provider's A–Z catalogue. Rows are replaced
+// wholesale by each index refresh, so UpdatedAt is the time of the last
+// refresh that saw the title.
+type AnimeIndex struct {
+	Provider  string    `gorm:"primaryKey;not null"`
+	AnimeSlug string    `gorm:"primaryKey;not null"`
+	Title     string    `gorm:"not null"`
+	URL       string    `gorm:"not null"`
+	Letter    string    `gorm:"index;not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+func (AnimeIndex) TableName() string {
+	return "anime_index"
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type ScrapeCache struct {
+	Key       string    `gorm:"primaryKey;not null"`
+	Value     []byte    `gorm:"not null"`
+	StoredAt  time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"index;not null"`
+}
+
+func (ScrapeCache) TableName() string {
+	return "scrape_cache"
+}
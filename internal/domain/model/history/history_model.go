@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// HistoryEntry is how far a user watched an episode. Each episode has one
+// entry per user, updated with every progress report.
+type HistoryEntry struct {
+	ID              uuid.UUID `gorm:"primaryKey;not null" json:"id"`
+	UserID          uuid.UUID `gorm:"uniqueIndex:idx_history_entries_episode;not null" json:"-"`
+	Provider        string    `gorm:"uniqueIndex:idx_history_entries_episode;not null" json:"provider"`
+	EpisodeSlug     string    `gorm:"uniqueIndex:idx_history_entries_episode;not null" json:"episode_slug"`
+	AnimeSlug       string    `gorm:"not null" json:"anime_slug"`
+	Title           string    `gorm:"not null" json:"title"`
+	Episode         string    `gorm:"not null" json:"episode"`
+	NextEpisodeSlug string    `gorm:"not null" json:"next_episode_slug"`
+	LookedUpAt      time.Time `gorm:"not null" json:"-"`
+	PositionSeconds int       `gorm:"not null" json:"position_seconds"`
+	DurationSeconds int       `gorm:"not null" json:"duration_seconds"`
+	Finished        bool      `gorm:"not null" json:"finished"`
+	WatchedAt       time.Time `gorm:"not null" json:"watched_at"`
+}
+
+func (entry *HistoryEntry) BeforeCreate(_ *gorm.DB) error {
+	entry.ID = uuid.New()
+	return nil
+}
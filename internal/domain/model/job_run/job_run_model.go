@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+const (
+	JobRunRunning = "running"
+	JobRunSuccess = "success"
+	JobRunFailed  = "failed"
+)
+
+// JobRun is one run of a scheduled background job. Runs stay "running" with
+// no FinishedAt when the process died before they ended.
+type JobRun struct {
+	ID         string    `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Job        string    `gorm:"index:idx_job_runs_job_started;not null"`
+	Status     string    `gorm:"not null"`
+	Error      string    `gorm:"not null"`
+	StartedAt  time.Time `gorm:"index:idx_job_runs_job_started;not null"`
+	FinishedAt *time.Time
+	DurationMS int64 `gorm:"not null"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+const EventEpisodeReleased = "episode_released"
+
+// ReleaseEvent records an episode first seen on a provider's listings. An
+// episode is recorded once, however often it is seen. Seq is assigned by the
+// database in the order events are committed.
+type ReleaseEvent struct {
+	ID           string    `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Seq          int64     `gorm:"->"`
+	Type         string    `gorm:"not null"`
+	Provider     string    `gorm:"uniqueIndex:idx_release_events_episode;not null"`
+	AnimeSlug    string    `gorm:"uniqueIndex:idx_release_events_episode;not null"`
+	Episode      int       `gorm:"uniqueIndex:idx_release_events_episode;not null"`
+	Title        string    `gorm:"not null"`
+	URL          string    `gorm:"not null"`
+	ThumbnailURL string    `gorm:"not null"`
+	DetectedAt   time.Time `gorm:"index;not null"`
+}
+
+// ReleaseState is the latest episode last seen for an anime, which the next
+// listing is diffed against.
+type ReleaseState struct {
+	Provider  string    `gorm:"primaryKey;not null"`
+	AnimeSlug string    `gorm:"primaryKey;not null"`
+	LatestEp  int       `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
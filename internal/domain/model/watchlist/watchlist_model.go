@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	StatusPlanToWatch = "plan_to_watch"
+	StatusWatching    = "watching"
+	StatusCompleted   = "completed"
+	StatusDropped     = "dropped"
+)
+
+// WatchlistItem is an anime a user bookmarked. A user lists an anime once.
+type WatchlistItem struct {
+	ID        uuid.UUID `gorm:"primaryKey;not null" json:"id"`
+	UserID    uuid.UUID `gorm:"uniqueIndex:idx_watchlist_items_anime;not null" json:"-"`
+	Provider  string    `gorm:"uniqueIndex:idx_watchlist_items_anime;not null" json:"provider"`
+	AnimeSlug string    `gorm:"uniqueIndex:idx_watchlist_items_anime;not null" json:"anime_slug"`
+	Status    string    `gorm:"not null" json:"status"`
+	Score     *int      `json:"score"`
+	Notes     string    `gorm:"not null" json:"notes"`
+	CreatedAt time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoCreateTime:milli;autoUpdateTime:milli" json:"updated_at"`
+}
+
+func (item *WatchlistItem) BeforeCreate(_ *gorm.DB) error {
+	item.ID = uuid.New()
+	return nil
+}
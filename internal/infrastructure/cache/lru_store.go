@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type lruItem struct {
+	key   string
+	entry *Entry
+}
+
+type lruStore struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// NewLRUStore returns an in-memory Store that evicts the least recently used
+// entry once it holds more than capacity keys.
+func NewLRUStore(capacity int) Store {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &lruStore{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (s *lruStore) Get(_ context.Context, key string) (*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.items[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+
+	item := el.Value.(*lruItem)
+	if item.entry.Expired(time.Now()) {
+		s.order.Remove(el)
+		delete(s.items, key)
+		return nil, ErrMiss
+	}
+
+	s.order.MoveToFront(el)
+	return item.entry, nil
+}
+
+func (s *lruStore) Set(_ context.Context, key string, entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		s.order.MoveToFront(el)
+		return nil
+	}
+
+	s.items[key] = s.order.PushFront(&lruItem{key: key, entry: entry})
+
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*lruItem).key)
+	}
+
+	return nil
+}
+
+func (s *lruStore) DeleteExpired(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	now := time.Now()
+	for key, el := range s.items {
+		if el.Value.(*lruItem).entry.Expired(now) {
+			s.order.Remove(el)
+			delete(s.items, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
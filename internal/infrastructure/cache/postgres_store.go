@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/cache"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresStore struct {
+	DB *gorm.DB
+}
+
+// NewPostgresStore returns a Store backed by the scrape_cache table, so cached
+// results survive restarts and are shared between instances.
+func NewPostgresStore(db *gorm.DB) Store {
+	return &postgresStore{
+		DB: db,
+	}
+}
+
+func (s *postgresStore) Get(ctx context.Context, key string) (*Entry, error) {
+	row := new(model.ScrapeCache)
+
+	err := s.DB.WithContext(ctx).Where("key = ? AND expires_at > ?", key, time.Now()).First(row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		Value:     row.Value,
+		StoredAt:  row.StoredAt,
+		ExpiresAt: row.ExpiresAt,
+	}, nil
+}
+
+func (s *postgresStore) Set(ctx context.Context, key string, entry *Entry) error {
+	row := &model.ScrapeCache{
+		Key:       key,
+		Value:     entry.Value,
+		StoredAt:  entry.StoredAt,
+		ExpiresAt: entry.ExpiresAt,
+	}
+
+	return s.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "stored_at", "expires_at"}),
+	}).Create(row).Error
+}
+
+func (s *postgresStore) DeleteExpired(ctx context.Context) (int64, error) {
+	result := s.DB.WithContext(ctx).Where("expires_at <= ?", time.Now()).Delete(&model.ScrapeCache{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrMiss = errors.New("cache miss")
+
+type Entry struct {
+	Value     []byte
+	StoredAt  time.Time
+	ExpiresAt time.Time
+}
+
+func (e *Entry) Expired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// Store is a key/value backend for cached scrape results. Get returns ErrMiss
+// when the key is absent or its entry has expired. DeleteExpired drops every
+// expired entry, returning how many were dropped.
+type Store interface {
+	Get(ctx context.Context, key string) (*Entry, error)
+	Set(ctx context.Context, key string, entry *Entry) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,200 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("circuit open")
+
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// BreakerStatus is a point-in-time view of one upstream's breaker.
+type BreakerStatus struct {
+	Name     string
+	State    BreakerState
+	Failures int
+	RetryAt  time.Time
+}
+
+// Breaker opens after threshold consecutive failures and rejects requests
+// until cooldown has passed. It then half-opens and lets a single probe
+// through: success closes it again, failure re-opens it for another cooldown.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(name string, threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		name:      name,
+		threshold: threshold,
+		cooldown:  cooldown,
+		state:     BreakerClosed,
+	}
+}
+
+// allow reports whether a request may be sent, moving an open breaker to
+// half-open once its cooldown has passed.
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cooldown {
+		b.state = BreakerHalfOpen
+	}
+
+	switch b.state {
+	case BreakerOpen:
+		return false
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+
+	return true
+}
+
+func (b *Breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *Breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// release frees a half-open probe slot without recording an outcome, used
+// when the caller cancelled and the upstream's health is still unknown.
+func (b *Breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *Breaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := BreakerStatus{Name: b.name, State: b.state, Failures: b.failures}
+	if b.state == BreakerOpen {
+		status.RetryAt = b.openedAt.Add(b.cooldown)
+	}
+
+	return status
+}
+
+// BreakerSet holds one breaker per tracked upstream. Requests to hosts that
+// were never tracked bypass the breakers entirely.
+type BreakerSet struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.RWMutex
+	byHost   map[string]*Breaker
+	breakers []*Breaker
+}
+
+func NewBreakerSet(threshold int, cooldown time.Duration) *BreakerSet {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &BreakerSet{
+		threshold: threshold,
+		cooldown:  cooldown,
+		byHost:    make(map[string]*Breaker),
+	}
+}
+
+// Track registers an upstream under name; every host shares its breaker.
+func (s *BreakerSet) Track(name string, hosts ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	breaker := newBreaker(name, s.threshold, s.cooldown)
+	s.breakers = append(s.breakers, breaker)
+	for _, host := range hosts {
+		s.byHost[host] = breaker
+	}
+}
+
+func (s *BreakerSet) get(host string) *Breaker {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.byHost[host]
+}
+
+// Statuses returns the state of every tracked upstream in registration order.
+func (s *BreakerSet) Statuses() []BreakerStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]BreakerStatus, 0, len(s.breakers))
+	for _, breaker := range s.breakers {
+		statuses = append(statuses, breaker.Status())
+	}
+
+	return statuses
+}
+
+type breakerTransport struct {
+	breakers *BreakerSet
+	next     http.RoundTripper
+}
+
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	breaker := t.breakers.get(req.URL.Hostname())
+	if breaker == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	if !breaker.allow() {
+		return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, req.URL.Host)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case errors.Is(req.Context().Err(), context.Canceled):
+		breaker.release()
+	case err != nil, resp.StatusCode >= http.StatusInternalServerError:
+		breaker.failure()
+	default:
+		breaker.success()
+	}
+
+	return resp, err
+}
@@ -0,0 +1,118 @@
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+var defaultUserAgents = []string{
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15",
+	"Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0",
+}
+
+type Config struct {
+	// ProxyURLs are http://, https:// or socks5:// proxies used round-robin.
+	// Requests go direct when empty.
+	ProxyURLs []string
+	// UserAgents rotate per request. A built-in browser pool is used when empty.
+	UserAgents          []string
+	MaxRetries          int
+	RetryBaseDelay      time.Duration
+	Timeout             time.Duration
+	MaxIdleConnsPerHost int
+	// Breakers fail requests to a tracked upstream fast while its circuit is
+	// open. Nil disables circuit breaking.
+	Breakers *BreakerSet
+	// PublicOnly refuses connections to private, loopback and link-local
+	// addresses, for clients that fetch URLs taken from scraped pages.
+	// Requests sent through ProxyURLs are only checked by the proxy.
+	PublicOnly bool
+}
+
+// New builds the HTTP client shared by every scraper and mirror resolver: one
+// pooled transport, one cookie jar, proxy rotation, user-agent rotation,
+// per-upstream circuit breakers and retries of GET and HEAD requests, with
+// exponential backoff, on 5xx responses and timeouts.
+func New(cfg Config) (*http.Client, error) {
+	proxy, err := proxyFunc(cfg.ProxyURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		return nil, err
+	}
+
+	base := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialContext(cfg.PublicOnly, cfg.ProxyURLs),
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+
+	userAgents := cfg.UserAgents
+	if len(userAgents) == 0 {
+		userAgents = defaultUserAgents
+	}
+
+	var transport http.RoundTripper = &retryTransport{
+		maxRetries: cfg.MaxRetries,
+		baseDelay:  cfg.RetryBaseDelay,
+		next:       base,
+	}
+	if cfg.Breakers != nil {
+		transport = &breakerTransport{breakers: cfg.Breakers, next: transport}
+	}
+
+	return &http.Client{
+		Jar:       jar,
+		Timeout:   cfg.Timeout,
+		Transport: &userAgentTransport{userAgents: userAgents, next: transport},
+	}, nil
+}
+
+func proxyFunc(rawURLs []string) (func(*http.Request) (*url.URL, error), error) {
+	if len(rawURLs) == 0 {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxies := make([]*url.URL, 0, len(rawURLs))
+	for _, raw := range rawURLs {
+		proxyURL, err := url.Parse(raw)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q", raw)
+		}
+		proxies = append(proxies, proxyURL)
+	}
+
+	var next atomic.Uint64
+	return func(_ *http.Request) (*url.URL, error) {
+		return proxies[(next.Add(1)-1)%uint64(len(proxies))], nil
+	}, nil
+}
+
+type userAgentTransport struct {
+	userAgents []string
+	next       http.RoundTripper
+	counter    atomic.Uint64
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgents[(t.counter.Add(1)-1)%uint64(len(t.userAgents))])
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,68 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var ErrPrivateAddress = errors.New("address not publicly routable")
+
+// dialContext dials like http.DefaultTransport. With publicOnly it refuses
+// private, loopback, link-local and unspecified addresses; the check runs on
+// the resolved address of every connection, so DNS answers and redirects are
+// covered too. Configured proxies are dialed without the check.
+func dialContext(publicOnly bool, proxyURLs []string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	direct := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !publicOnly {
+		return direct.DialContext
+	}
+
+	guarded := &net.Dialer{Timeout: direct.Timeout, KeepAlive: direct.KeepAlive, Control: publicAddress}
+	proxies := proxyAddrs(proxyURLs)
+
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if proxies[addr] {
+			return direct.DialContext(ctx, network, addr)
+		}
+		return guarded.DialContext(ctx, network, addr)
+	}
+}
+
+func publicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	return nil
+}
+
+// proxyAddrs returns the host:port each proxy is dialed at.
+func proxyAddrs(rawURLs []string) map[string]bool {
+	defaultPorts := map[string]string{"http": "80", "https": "443", "socks5": "1080"}
+
+	addrs := make(map[string]bool, len(rawURLs))
+	for _, raw := range rawURLs {
+		proxyURL, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+
+		port := proxyURL.Port()
+		if port == "" {
+			port = defaultPorts[proxyURL.Scheme]
+		}
+		addrs[net.JoinHostPort(proxyURL.Hostname(), port)] = true
+	}
+	return addrs
+}
@@ -0,0 +1,77 @@
+package httpclient
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+type retryTransport struct {
+	maxRetries int
+	baseDelay  time.Duration
+	next       http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if req.Body != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		select {
+		case <-time.After(t.backoff(attempt)):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// backoff doubles the base delay per attempt and adds up to 50% jitter.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.baseDelay << attempt
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+// retryable reports whether req may be sent again after resp or err. Only
+// GET and HEAD requests are retried: a POST such as an admin-ajax call may
+// have taken effect upstream even though it failed.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout() || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,225 @@
+package modules
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+)
+
+// The parsers below turn otakudesu's Indonesian, human formatted text into
+// typed values. Each returns nil (or the zero/unknown value) when the text
+// doesn't hold a usable value, e.g. "?" or "Unknown Eps".
+
+var (
+	intPattern      = regexp.MustCompile(`\d+`)
+	scorePattern    = regexp.MustCompile(`\d+(?:[.,]\d+)?`)
+	hourPattern     = regexp.MustCompile(`(?i)(\d+)\s*(?:hr|jam|hour)`)
+	minutePattern   = regexp.MustCompile(`(?i)(\d+)\s*(?:min|menit)`)
+	releaseLayouts  = []string{"Jan 02, 2006", "Jan 2, 2006", "02 Jan 2006", "2 Jan 2006", "January 2, 2006", "2 January 2006", "2006-01-02"}
+	indonesianMonth = strings.NewReplacer(
+		"Januari", "January", "Februari", "February", "Maret", "March", "Mei", "May",
+		"Juni", "June", "Juli", "July", "Agustus", "August", "Oktober", "October", "Desember", "December",
+		"Agt", "Aug", "Agu", "Aug", "Okt", "Oct", "Des", "Dec",
+	)
+	resolutionPattern = regexp.MustCompile(`(?i)(\d{3,4})\s*p\b`)
+	formatPattern     = regexp.MustCompile(`(?i)\b(mp4|mkv|webm|m3u8)\b`)
+	sizePattern       = regexp.MustCompile(`(?i)(\d+(?:[.,]\d+)?)\s*(kb|mb|gb)`)
+	sizeUnits         = map[string]float64{"kb": 1 << 10, "mb": 1 << 20, "gb": 1 << 30}
+	indonesianDay     = map[string]string{
+		"senin":  "monday",
+		"selasa": "tuesday",
+		"rabu":   "wednesday",
+		"kamis":  "thursday",
+		"jumat":  "friday",
+		"jum'at": "friday",
+		"sabtu":  "saturday",
+		"minggu": "sunday",
+	}
+)
+
+// labelValue strips a leading "Label:" or "Label :" from text.
+func labelValue(text, label string) string {
+	text = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), label))
+	return strings.TrimSpace(strings.TrimPrefix(text, ":"))
+}
+
+// ParseScore reads a score such as "7.12" or "7,12".
+func ParseScore(text string) *float64 {
+	match := scorePattern.FindString(text)
+	if match == "" {
+		return nil
+	}
+
+	score, err := strconv.ParseFloat(strings.Replace(match, ",", ".", 1), 64)
+	if err != nil {
+		return nil
+	}
+	return &score
+}
+
+// ParseCount reads the first integer in text, e.g. 12 from "12 Eps" or
+// 8 from "Episode 8".
+func ParseCount(text string) *int {
+	match := intPattern.FindString(text)
+	if match == "" {
+		return nil
+	}
+
+	count, err := strconv.Atoi(match)
+	if err != nil {
+		return nil
+	}
+	return &count
+}
+
+// ParseDurationMinutes reads durations like "23 min. per ep." or
+// "1 hr. 30 min." as a number of minutes.
+func ParseDurationMinutes(text string) *int {
+	minutes := 0
+	matched := false
+
+	if m := hourPattern.FindStringSubmatch(text); m != nil {
+		hours, _ := strconv.Atoi(m[1])
+		minutes += hours * 60
+		matched = true
+	}
+	if m := minutePattern.FindStringSubmatch(text); m != nil {
+		mins, _ := strconv.Atoi(m[1])
+		minutes += mins
+		matched = true
+	}
+
+	if !matched {
+		return nil
+	}
+	return &minutes
+}
+
+// ParseReleaseDate reads English or Indonesian dates such as "Apr 05, 2025"
+// or "5 Mei 2025" and returns them as an ISO-8601 date (2025-04-05).
+func ParseReleaseDate(text string) *string {
+	text = indonesianMonth.Replace(strings.TrimSpace(text))
+
+	for _, layout := range releaseLayouts {
+		if t, err := time.Parse(layout, text); err == nil {
+			date := t.Format(time.DateOnly)
+			return &date
+		}
+	}
+	return nil
+}
+
+// ParseStatus maps "Ongoing", "On-Going", "Completed" or "Tamat" onto an AnimeStatus.
+func ParseStatus(text string) od_anime_entity.AnimeStatus {
+	status := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(text), "-", ""))
+
+	switch {
+	case strings.HasPrefix(status, "ongoing"):
+		return od_anime_entity.StatusOngoing
+	case strings.HasPrefix(status, "completed"), strings.HasPrefix(status, "complete"), strings.HasPrefix(status, "tamat"):
+		return od_anime_entity.StatusCompleted
+	}
+	return od_anime_entity.StatusUnknown
+}
+
+// ParseReleaseDay maps an Indonesian weekday ("Senin") to its lowercase
+// English name ("monday"), or "" when text isn't a weekday.
+func ParseReleaseDay(text string) string {
+	return indonesianDay[strings.ToLower(strings.TrimSpace(text))]
+}
+
+// IndexLetter returns the A–Z index letter for a title filed under heading:
+// the heading when it is a single letter, otherwise the title's initial.
+// Anything that isn't a latin letter is filed under "#".
+func IndexLetter(heading, title string) string {
+	initial := strings.ToUpper(strings.TrimSpace(heading))
+	if len(initial) != 1 {
+		initial = strings.ToUpper(strings.TrimSpace(title))
+	}
+
+	if initial != "" && initial[0] >= 'A' && initial[0] <= 'Z' {
+		return initial[:1]
+	}
+	return "#"
+}
+
+// ParseResolution reads the vertical resolution from a quality label such as
+// "Mp4 720p" or "MKV 1080p". "FULLHD"/"FHD" and "HD" are read as 1080 and 720.
+func ParseResolution(text string) *int {
+	if m := resolutionPattern.FindStringSubmatch(text); m != nil {
+		resolution, _ := strconv.Atoi(m[1])
+		return &resolution
+	}
+
+	upper := strings.ToUpper(text)
+	switch {
+	case strings.Contains(upper, "FULLHD"), strings.Contains(upper, "FHD"):
+		resolution := 1080
+		return &resolution
+	case strings.Contains(upper, "HD"):
+		resolution := 720
+		return &resolution
+	}
+	return nil
+}
+
+// ParseFormat reads the lowercase container name from a quality label or
+// URL, e.g. "mp4" from "Mp4 360p", or "" when none is named.
+func ParseFormat(text string) string {
+	return strings.ToLower(formatPattern.FindString(text))
+}
+
+// ParseSize reads a file size such as "37.4 MB" or "1,2 GB" as bytes, using
+// binary units as the upstream hosts do.
+func ParseSize(text string) *int64 {
+	m := sizePattern.FindStringSubmatch(text)
+	if m == nil {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(strings.Replace(m[1], ",", ".", 1), 64)
+	if err != nil {
+		return nil
+	}
+
+	size := int64(value * sizeUnits[strings.ToLower(m[2])])
+	return &size
+}
+
+// pathSlug returns the path segment following section in rawURL, e.g.
+// "zatsu-tabi-sub-indo" for section "anime" and ".../anime/zatsu-tabi-sub-indo/".
+// It returns "" when rawURL isn't a link into that section.
+func pathSlug(rawURL, section string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == section {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+func animeSlug(rawURL string) string {
+	return pathSlug(rawURL, "anime")
+}
+
+func episodeSlug(rawURL string) string {
+	return pathSlug(rawURL, "episode")
+}
+
+func batchSlug(rawURL string) string {
+	return pathSlug(rawURL, "batch")
+}
+
+func genreSlug(rawURL string) string {
+	return pathSlug(rawURL, "genres")
+}
@@ -0,0 +1,51 @@
+package modules
+
+import (
+	"strconv"
+	"strings"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+
+	"github.com/gocolly/colly"
+)
+
+// pager collects paging info from otakudesu's ".pagination" block:
+// numbered ".page-numbers" links, a ".current" one and a ".next" link.
+type pager struct {
+	current int
+	last    int
+	hasNext bool
+}
+
+func onPager(c *colly.Collector, p *pager) {
+	c.OnHTML(".pagination .page-numbers", func(e *colly.HTMLElement) {
+		if e.DOM.HasClass("next") {
+			p.hasNext = true
+			return
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(e.Text))
+		if err != nil {
+			return
+		}
+		if n > p.last {
+			p.last = n
+		}
+		if e.DOM.HasClass("current") {
+			p.current = n
+		}
+	})
+}
+
+// listPage wraps items with the paging info read by p. A listing without a
+// pager is treated as a single page.
+func listPage[T any](items []T, p pager) od_anime_entity.ListPage[T] {
+	page := max(p.current, 1)
+
+	return od_anime_entity.ListPage[T]{
+		Items:      items,
+		Page:       page,
+		TotalPages: max(p.last, page),
+		HasNext:    p.hasNext,
+	}
+}
@@ -0,0 +1,89 @@
+package modules
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
+)
+
+const (
+	ProviderName = "otakudesu"
+	MainHost     = "otakudesu.cloud"
+	MainURL      = "https://" + MainHost
+)
+
+type otakudesuProvider struct {
+	baseURL   string
+	client    *http.Client
+	resolvers *resolver.Registry
+}
+
+// NewProvider scrapes baseURL through client; download mirrors are resolved
+// to direct URLs with resolvers.
+func NewProvider(baseURL string, client *http.Client, resolvers *resolver.Registry) od_anime_entity.Provider {
+	return &otakudesuProvider{
+		baseURL:   baseURL,
+		client:    client,
+		resolvers: resolvers,
+	}
+}
+
+func (p *otakudesuProvider) Name() string {
+	return ProviderName
+}
+
+func (p *otakudesuProvider) ScrapeHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+	return ScrapeHomePage(ctx, p.client, p.baseURL+"/")
+}
+
+func (p *otakudesuProvider) ScrapeAnimeDetail(ctx context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	return ScrapeAnimeEpisodes(ctx, p.client, p.baseURL+"/anime/"+url.PathEscape(judul))
+}
+
+func (p *otakudesuProvider) ScrapeAnimeSourceData(ctx context.Context, judulEps string) (od_anime_entity.AnimeSourceData, error) {
+	return ScrapeAnimeSourceData(ctx, p.client, p.resolvers, p.baseURL+"/episode/"+url.PathEscape(judulEps))
+}
+
+func (p *otakudesuProvider) ResolveStreamServer(ctx context.Context, judulEps, serverID string) (od_anime_entity.StreamServer, error) {
+	return ScrapeStreamServer(ctx, p.client, p.baseURL+"/episode/"+url.PathEscape(judulEps), serverID)
+}
+
+func (p *otakudesuProvider) ScrapeAnimeBatch(ctx context.Context, batchSlug string) (od_anime_entity.AnimeBatch, error) {
+	return ScrapeAnimeBatch(ctx, p.client, p.resolvers, p.baseURL+"/batch/"+url.PathEscape(batchSlug))
+}
+
+func (p *otakudesuProvider) ScrapeGenres(ctx context.Context) ([]od_anime_entity.GenreInfo, error) {
+	return ScrapeGenreList(ctx, p.client, p.baseURL+"/genre-list/")
+}
+
+func (p *otakudesuProvider) ScrapeGenreAnime(ctx context.Context, genre string, page int) (od_anime_entity.ListPage[od_anime_entity.GenreAnime], error) {
+	return ScrapeGenreAnime(ctx, p.client, p.baseURL+"/genres/"+url.PathEscape(genre)+"/page/"+strconv.Itoa(page))
+}
+
+func (p *otakudesuProvider) ScrapeSearchAnime(ctx context.Context, title string, page int) (od_anime_entity.ListPage[od_anime_entity.SearchResult], error) {
+	query := "?s=" + url.QueryEscape(title) + "&post_type=anime"
+	if page > 1 {
+		return ScrapeSearchAnimeByTitle(ctx, p.client, p.baseURL+"/page/"+strconv.Itoa(page)+"/"+query)
+	}
+	return ScrapeSearchAnimeByTitle(ctx, p.client, p.baseURL+"/"+query)
+}
+
+func (p *otakudesuProvider) ScrapeOngoingAnime(ctx context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	return ScrapeOngoingAnime(ctx, p.client, p.baseURL+"/ongoing-anime/page/"+strconv.Itoa(page))
+}
+
+func (p *otakudesuProvider) ScrapeSchedule(ctx context.Context) ([]od_anime_entity.ScheduleDay, error) {
+	return ScrapeSchedule(ctx, p.client, p.baseURL+"/jadwal-rilis/")
+}
+
+func (p *otakudesuProvider) ScrapeAnimeIndex(ctx context.Context) ([]od_anime_entity.AnimeIndexEntry, error) {
+	return ScrapeAnimeIndex(ctx, p.client, p.baseURL+"/anime-list/")
+}
+
+func (p *otakudesuProvider) ScrapeCompletedAnime(ctx context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	return ScrapeCompletedAnime(ctx, p.client, p.baseURL+"/complete-anime/page/"+strconv.Itoa(page))
+}
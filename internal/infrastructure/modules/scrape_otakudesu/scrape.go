@@ -1,62 +1,84 @@
 package modules
 
 import (
-	"log"
+	"context"
 	"net/http"
-	"path"
 	"regexp"
 	"strings"
 	"sync"
 
 	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gocolly/colly"
 )
 
-func ScrapeHomePage() []od_anime_entity.AnimeData {
-	c := colly.NewCollector(colly.UserAgent("Mozilla/5.0"))
+func ScrapeHomePage(ctx context.Context, client *http.Client, url string) ([]od_anime_entity.AnimeData, error) {
+	c := newCollector(ctx, client)
 	var results []od_anime_entity.AnimeData
 
 	c.OnHTML(".venz li", func(e *colly.HTMLElement) {
-		results = append(results, od_anime_entity.AnimeData{
-			Title:        e.ChildText(".jdlflm"),
-			URL:          e.ChildAttr(".thumb a", "href"),
-			JudulPath:    strings.TrimSuffix(path.Base(e.ChildAttr(".thumb a", "href")), "/"),
-			ThumbnailURL: e.ChildAttr(".thumb img", "src"),
-			LatestEp:     e.ChildText(".epz"),
-			UpdateAnime:  e.ChildText(".epztipe"),
-		})
+		results = append(results, animeData(e, ".thumb img"))
+	})
+
+	if err := visit(ctx, c, "ScrapeHomePage", url, ".venz"); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func ScrapeGenreList(ctx context.Context, client *http.Client, url string) ([]od_anime_entity.GenreInfo, error) {
+	c := newCollector(ctx, client)
+	var results []od_anime_entity.GenreInfo
+
+	c.OnHTML("ul.genres li a", func(e *colly.HTMLElement) {
+		href := e.Request.AbsoluteURL(e.Attr("href"))
+		if slug := genreSlug(href); slug != "" {
+			results = append(results, od_anime_entity.GenreInfo{
+				Title: strings.TrimSpace(e.Text),
+				URL:   href,
+				Slug:  slug,
+			})
+		}
 	})
-	_ = c.Visit("https://otakudesu.cloud/")
-	return results
+
+	if err := visit(ctx, c, "ScrapeGenreList", url, "ul.genres"); err != nil {
+		return nil, err
+	}
+	return results, nil
 }
 
-func ScrapeGenreAnime(url string) []od_anime_entity.GenreAnime {
-	c := colly.NewCollector(colly.UserAgent("Mozilla/5.0"))
+func ScrapeGenreAnime(ctx context.Context, client *http.Client, url string) (od_anime_entity.ListPage[od_anime_entity.GenreAnime], error) {
+	c := newCollector(ctx, client)
 	var results []od_anime_entity.GenreAnime
+	var paging pager
+	onPager(c, &paging)
 
 	c.OnHTML(".col-anime", func(e *colly.HTMLElement) {
-		results = append(results, od_anime_entity.GenreAnime{
-			Title:    e.ChildText(".col-anime-title a"),
-			URL:      e.ChildAttr(".col-anime-title a", "href"),
-			Studio:   e.ChildText(".col-anime-studio"),
+		raw := od_anime_entity.GenreAnimeRaw{
 			Episodes: e.ChildText(".col-anime-eps"),
 			Rating:   e.ChildText(".col-anime-rating"),
+		}
+		results = append(results, od_anime_entity.GenreAnime{
+			Title:     e.ChildText(".col-anime-title a"),
+			URL:       e.ChildAttr(".col-anime-title a", "href"),
+			AnimeSlug: animeSlug(e.ChildAttr(".col-anime-title a", "href")),
+			Studio:    e.ChildText(".col-anime-studio"),
+			Episodes:  ParseCount(raw.Episodes),
+			Rating:    ParseScore(raw.Rating),
+			Raw:       raw,
 		})
 	})
-	_ = c.Visit(url)
-	if len(results) > 20 {
-		return results[:20]
+
+	if err := visit(ctx, c, "ScrapeGenreAnime", url, ".venser"); err != nil {
+		return od_anime_entity.ListPage[od_anime_entity.GenreAnime]{}, err
 	}
-	return results
+	return listPage(results, paging), nil
 }
 
-func ScrapeAnimeEpisodes(url string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode) {
-	c := colly.NewCollector(
-		colly.UserAgent("Mozilla/5.0"),
-		colly.Async(true),
-	)
+func ScrapeAnimeEpisodes(ctx context.Context, client *http.Client, url string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	c := newCollector(ctx, client, colly.Async(true))
 	var (
 		detail   od_anime_entity.AnimeDetail
 		episodes []od_anime_entity.AnimeEpisode
@@ -73,148 +95,224 @@ func ScrapeAnimeEpisodes(url string) (od_anime_entity.AnimeDetail, []od_anime_en
 		e.ForEach("span b", func(_ int, el *colly.HTMLElement) {
 			if strings.Contains(el.Text, "Genre") {
 				el.DOM.Parent().Find("a").Each(func(_ int, s *goquery.Selection) {
-					genres = append(genres, od_anime_entity.GenreInfo{
-						Title: s.Text(),
-						URL:   s.AttrOr("href", ""),
-					})
+					genres = append(genres, genreInfo(s))
 				})
 			}
 		})
 
+		raw := od_anime_entity.AnimeDetailRaw{
+			Rating:      labelValue(e.ChildText("p:contains('Skor')"), "Skor"),
+			Status:      labelValue(e.ChildText("p:contains('Status')"), "Status"),
+			TotalEps:    labelValue(e.ChildText("p:contains('Total Episode')"), "Total Episode"),
+			Duration:    labelValue(e.ChildText("p:contains('Durasi')"), "Durasi"),
+			ReleaseDate: labelValue(e.ChildText("p:contains('Tanggal Rilis')"), "Tanggal Rilis"),
+		}
+
 		detail = od_anime_entity.AnimeDetail{
-			ThumbnailURL: e.DOM.Parent().Find("img").AttrOr("src", ""),
-			Title:        strings.TrimPrefix(e.ChildText("p:contains('Judul')"), "Judul: "),
-			Rating:       strings.TrimPrefix(e.ChildText("p:contains('Skor')"), "Skor: "),
-			Producer:     strings.TrimPrefix(e.ChildText("p:contains('Produser')"), "Produser: "),
-			Status:       strings.TrimPrefix(e.ChildText("p:contains('Status')"), "Status: "),
-			TotalEps:     strings.TrimPrefix(e.ChildText("p:contains('Total Episode')"), "Total Episode: "),
-			Duration:     strings.TrimSuffix(strings.TrimPrefix(e.ChildText("p:contains('Durasi')"), "Durasi: "), "per ep."),
-			Studio:       strings.TrimPrefix(e.ChildText("p:contains('Studio:')"), "Studio: "),
-			ReleaseDate:  e.ChildText("p:contains('Tanggal Rilis')"),
-			Genres:       genres,
-			Synopsis:     e.DOM.SiblingsFiltered(".sinopc").Find("p").Text(),
+			AnimeSlug:       animeSlug(url),
+			ThumbnailURL:    e.DOM.Parent().Find("img").AttrOr("src", ""),
+			Title:           labelValue(e.ChildText("p:contains('Judul')"), "Judul"),
+			Rating:          ParseScore(raw.Rating),
+			Producer:        labelValue(e.ChildText("p:contains('Produser')"), "Produser"),
+			Status:          ParseStatus(raw.Status),
+			TotalEps:        ParseCount(raw.TotalEps),
+			DurationMinutes: ParseDurationMinutes(raw.Duration),
+			Studio:          labelValue(e.ChildText("p:contains('Studio:')"), "Studio"),
+			ReleaseDate:     ParseReleaseDate(raw.ReleaseDate),
+			Genres:          genres,
+			Synopsis:        e.DOM.SiblingsFiltered(".sinopc").Find("p").Text(),
+			Raw:             raw,
 		}
 	})
 
+	// The batch download, when there is one, is listed like an episode.
+	var batch string
 	c.OnHTML(".episodelist li", func(e *colly.HTMLElement) {
-		episodes = append(episodes, od_anime_entity.AnimeEpisode{
-			Title:    e.ChildText("span a"),
-			VideoURL: e.ChildAttr("span a", "href"),
-		})
+		if slug := batchSlug(e.ChildAttr("span a", "href")); slug != "" {
+			batch = slug
+			return
+		}
+		episodes = append(episodes, episodeLink(e, "span a"))
 	})
 
-	_ = c.Visit(url)
-	c.Wait()
+	if err := visit(ctx, c, "ScrapeAnimeEpisodes", url, ".infozingle"); err != nil {
+		return od_anime_entity.AnimeDetail{}, nil, err
+	}
+
+	detail.BatchSlug = batch
+	return detail, episodes, nil
+}
+
+func ScrapeAnimeBatch(ctx context.Context, client *http.Client, resolvers *resolver.Registry, url string) (od_anime_entity.AnimeBatch, error) {
+	c := newCollector(ctx, client)
+	var result od_anime_entity.AnimeBatch
+
+	c.OnHTML(".jdlrx h1", func(e *colly.HTMLElement) {
+		result.Title = strings.TrimSpace(e.Text)
+	})
+
+	c.OnHTML(".batchlink ul li", func(e *colly.HTMLElement) {
+		source, streams := videoSource(ctx, resolvers, e)
+		result.Sources = append(result.Sources, source)
+		result.Streams = append(result.Streams, streams...)
+	})
+
+	if err := visit(ctx, c, "ScrapeAnimeBatch", url, ".batchlink"); err != nil {
+		return od_anime_entity.AnimeBatch{}, err
+	}
 
-	return detail, episodes
+	result.BatchSlug = batchSlug(url)
+	result.Streams = SortStreams(result.Streams)
+	return result, nil
 }
 
-func ScrapeSearchAnimeByTitle(url string) []od_anime_entity.SearchResult {
-	c := colly.NewCollector(colly.UserAgent("Mozilla/5.0"))
+func ScrapeSearchAnimeByTitle(ctx context.Context, client *http.Client, url string) (od_anime_entity.ListPage[od_anime_entity.SearchResult], error) {
+	c := newCollector(ctx, client)
 	var results []od_anime_entity.SearchResult
+	var paging pager
+	onPager(c, &paging)
 
 	c.OnHTML("ul.chivsrc li", func(e *colly.HTMLElement) {
 		var genres []od_anime_entity.GenreInfo
 		e.ForEach(".set b", func(_ int, el *colly.HTMLElement) {
 			if strings.Contains(el.Text, "Genres") {
 				el.DOM.Parent().Find("a").Each(func(_ int, s *goquery.Selection) {
-					genres = append(genres, od_anime_entity.GenreInfo{
-						Title: s.Text(),
-						URL:   s.AttrOr("href", ""),
-					})
+					genres = append(genres, genreInfo(s))
 				})
 			}
 		})
+		raw := od_anime_entity.SearchResultRaw{
+			Status: setValue(e, "Status"),
+			Rating: setValue(e, "Rating"),
+		}
 		results = append(results, od_anime_entity.SearchResult{
 			Title:        e.ChildText("h2 a"),
 			URL:          e.ChildAttr("h2 a", "href"),
+			AnimeSlug:    animeSlug(e.ChildAttr("h2 a", "href")),
 			ThumbnailURL: e.ChildAttr("img", "src"),
 			Genres:       genres,
-			Status:       e.ChildText(".set b:contains('Status')"),
-			Rating:       e.ChildText(".set b:contains('Rating')"),
+			Status:       ParseStatus(raw.Status),
+			Rating:       ParseScore(raw.Rating),
+			Raw:          raw,
 		})
 	})
-	_ = c.Visit(url)
-	if len(results) > 15 {
-		return results[:15]
+
+	if err := visit(ctx, c, "ScrapeSearchAnimeByTitle", url, ".venser"); err != nil {
+		return od_anime_entity.ListPage[od_anime_entity.SearchResult]{}, err
 	}
-	return results
+	return listPage(results, paging), nil
+}
+
+func ScrapeOngoingAnime(ctx context.Context, client *http.Client, url string) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	return scrapeAnimeList(ctx, client, "ScrapeOngoingAnime", url)
+}
+
+func ScrapeCompletedAnime(ctx context.Context, client *http.Client, url string) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	return scrapeAnimeList(ctx, client, "ScrapeCompletedAnime", url)
 }
 
-func ScrapeOngoingAnime(url string) []od_anime_entity.AnimeData {
-	c := colly.NewCollector(colly.UserAgent("Mozilla/5.0"))
+// scrapeAnimeList reads one page of the ongoing or completed listing, which
+// share the ".venz" card layout and pager.
+func scrapeAnimeList(ctx context.Context, client *http.Client, op, url string) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	c := newCollector(ctx, client)
 	var results []od_anime_entity.AnimeData
+	var paging pager
+	onPager(c, &paging)
 
 	c.OnHTML(".venz li", func(e *colly.HTMLElement) {
-		results = append(results, od_anime_entity.AnimeData{
-			Title:        e.ChildText(".jdlflm"),
-			LatestEp:     e.ChildText(".epz"),
-			URL:          e.ChildAttr(".thumb a", "href"),
-			UpdateAnime:  e.ChildText(".epztipe"),
-			ThumbnailURL: e.ChildAttr(".thumbz img", "src"),
+		results = append(results, animeData(e, ".thumbz img"))
+	})
+
+	if err := visit(ctx, c, op, url, ".venz"); err != nil {
+		return od_anime_entity.ListPage[od_anime_entity.AnimeData]{}, err
+	}
+	return listPage(results, paging), nil
+}
+
+func ScrapeSchedule(ctx context.Context, client *http.Client, url string) ([]od_anime_entity.ScheduleDay, error) {
+	c := newCollector(ctx, client)
+	var results []od_anime_entity.ScheduleDay
+
+	c.OnHTML(".kglist321", func(e *colly.HTMLElement) {
+		raw := od_anime_entity.ScheduleDayRaw{Day: e.ChildText("h2")}
+		day := od_anime_entity.ScheduleDay{
+			Day:   ParseReleaseDay(raw.Day),
+			Anime: []od_anime_entity.ScheduleAnime{},
+			Raw:   raw,
+		}
+		if day.Day == "" {
+			day.Day = strings.ToLower(raw.Day)
+		}
+
+		e.ForEach("ul li", func(_ int, li *colly.HTMLElement) {
+			href := li.Request.AbsoluteURL(li.ChildAttr("a", "href"))
+			day.Anime = append(day.Anime, od_anime_entity.ScheduleAnime{
+				Title:        li.ChildText("a"),
+				URL:          href,
+				AnimeSlug:    animeSlug(href),
+				ThumbnailURL: li.ChildAttr("img", "src"),
+			})
+		})
+
+		results = append(results, day)
+	})
+
+	if err := visit(ctx, c, "ScrapeSchedule", url, ".kgjdwl321"); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ScrapeAnimeIndex reads the whole A–Z anime list, which upstream serves as a
+// single page grouped under one heading per initial.
+func ScrapeAnimeIndex(ctx context.Context, client *http.Client, url string) ([]od_anime_entity.AnimeIndexEntry, error) {
+	c := newCollector(ctx, client)
+	var results []od_anime_entity.AnimeIndexEntry
+
+	c.OnHTML(".daftarkartun .bariskelom", func(e *colly.HTMLElement) {
+		heading := e.ChildText(".barispenz")
+
+		e.ForEach(".jdlbar a", func(_ int, a *colly.HTMLElement) {
+			href := a.Request.AbsoluteURL(a.Attr("href"))
+			slug := animeSlug(href)
+			if slug == "" {
+				return
+			}
+
+			title := strings.TrimSpace(a.Text)
+			results = append(results, od_anime_entity.AnimeIndexEntry{
+				Title:     title,
+				URL:       href,
+				AnimeSlug: slug,
+				Letter:    IndexLetter(heading, title),
+			})
 		})
 	})
-	_ = c.Visit(url)
-	if len(results) > 15 {
-		return results[:15]
+
+	if err := visit(ctx, c, "ScrapeAnimeIndex", url, ".daftarkartun"); err != nil {
+		return nil, err
 	}
-	return results
+	return results, nil
 }
 
-func ScrapeAnimeSourceData(url string) od_anime_entity.AnimeSourceData {
-	c := colly.NewCollector()
+func ScrapeAnimeSourceData(ctx context.Context, client *http.Client, resolvers *resolver.Registry, url string) (od_anime_entity.AnimeSourceData, error) {
+	c := newCollector(ctx, client)
 	var epsList []od_anime_entity.AnimeEpisode
 	var animeSource []od_anime_entity.VideoSource
+	var streams []od_anime_entity.StreamSource
+	var mirrors []streamMirror
 	var result od_anime_entity.AnimeSourceData
 
+	onStreamMirrors(c, &mirrors)
+
 	c.OnHTML(".keyingpost li", func(e *colly.HTMLElement) {
-		epsList = append(epsList, od_anime_entity.AnimeEpisode{
-			Title:    e.ChildText("a"),
-			VideoURL: e.ChildAttr("a", "href"),
-		})
+		epsList = append(epsList, episodeLink(e, "a"))
 	})
 
 	c.OnHTML(".download ul li", func(e *colly.HTMLElement) {
-		var dataList []od_anime_entity.AnimeEpisode
-		titleRes := e.ChildText("strong")
-
-		var wg sync.WaitGroup
-		var mu sync.Mutex
-
-		e.ForEach("a", func(_ int, el *colly.HTMLElement) {
-			title := strings.TrimSpace(el.Text)
-			link := el.Attr("href")
-
-			if strings.EqualFold(title, "pdrain") {
-				wg.Add(1)
-				go func(title, link string) {
-					defer wg.Done()
-					extracted := ExtractPdrainUrl(link)
-					if extracted != "" {
-						mu.Lock()
-						dataList = append(dataList, od_anime_entity.AnimeEpisode{
-							Title:    title,
-							VideoURL: extracted,
-						})
-						mu.Unlock()
-					}
-				}(title, link)
-			} else {
-				mu.Lock()
-				dataList = append(dataList, od_anime_entity.AnimeEpisode{
-					Title:    title,
-					VideoURL: link,
-				})
-				mu.Unlock()
-			}
-		})
-
-		wg.Wait()
-
-		animeSource = append(animeSource, od_anime_entity.VideoSource{
-			Res:      titleRes,
-			DataList: dataList,
-		})
+		source, rowStreams := videoSource(ctx, resolvers, e)
+		animeSource = append(animeSource, source)
+		streams = append(streams, rowStreams...)
 	})
 
 	c.OnHTML(".venutama h1.posttl", func(e *colly.HTMLElement) {
@@ -233,82 +331,121 @@ func ScrapeAnimeSourceData(url string) od_anime_entity.AnimeSourceData {
 	})
 
 	c.OnHTML(".responsive-embed-stream iframe", func(e *colly.HTMLElement) {
-		result.DownloadURL = e.Attr("src")
+		result.EmbedURL = e.Attr("src")
+		result.DownloadURL = result.EmbedURL
 	})
 
 	c.OnHTML(".flir a", func(e *colly.HTMLElement) {
-		if strings.Contains(e.Text, "Next Eps.") {
-			result.NextEpURL = e.Attr("href")
+		href := e.Attr("href")
+		switch {
+		case strings.Contains(e.Text, "Next Eps."):
+			result.NextEpURL = href
+			result.NextEpisodeSlug = episodeSlug(href)
+		case strings.Contains(e.Text, "Previous Eps."):
+			result.PrevEpisodeSlug = episodeSlug(href)
+		case animeSlug(href) != "":
+			result.AnimeSlug = animeSlug(href)
 		}
 	})
 
-	if err := c.Visit(url); err != nil {
-		log.Println("Visit error:", err)
+	if err := visit(ctx, c, "ScrapeAnimeSourceData", url, ".venutama"); err != nil {
+		return od_anime_entity.AnimeSourceData{}, err
 	}
 
+	result.EpisodeSlug = episodeSlug(url)
 	result.Episodes = epsList
 	result.Sources = animeSource
-	return result
+	result.Streams = SortStreams(streams)
+	result.StreamServers = resolveServers(ctx, client, url, mirrors)
+	return result, nil
 }
 
-func ExtractPdrainUrl(url string) string {
-	res, err := http.Get(url)
-	if err != nil {
-		log.Println("Error fetching Pdrain URL:", err)
-		return ""
+// videoSource reads one resolution row of a download list, e.g.
+// <li><strong>Mp4 360p</strong> <a>Pdrain</a> <a>Mega</a> <i>37.4 MB</i></li>,
+// as the legacy VideoSource and as one StreamSource per mirror.
+func videoSource(ctx context.Context, resolvers *resolver.Registry, e *colly.HTMLElement) (od_anime_entity.VideoSource, []od_anime_entity.StreamSource) {
+	quality := e.ChildText("strong")
+	size := e.ChildText("i")
+
+	// Mirrors keep their page order; resolvable links are resolved concurrently.
+	streams := make([]od_anime_entity.StreamSource, e.DOM.Find("a").Length())
+	var wg sync.WaitGroup
+
+	e.ForEach("a", func(i int, el *colly.HTMLElement) {
+		streams[i] = streamSource(quality, strings.TrimSpace(el.Text), size, el.Attr("href"))
+
+		if resolvers.Supports(streams[i].Raw.Host, streams[i].URL) {
+			wg.Add(1)
+			go func(stream *od_anime_entity.StreamSource) {
+				defer wg.Done()
+				resolveStream(ctx, resolvers, stream)
+			}(&streams[i])
+		}
+	})
+
+	wg.Wait()
+
+	// Mirrors whose resolver failed only lead to a landing page and are left
+	// out of the legacy list.
+	var dataList []od_anime_entity.AnimeEpisode
+	for _, stream := range streams {
+		if stream.ResolverStatus != od_anime_entity.ResolverFailed {
+			dataList = append(dataList, od_anime_entity.AnimeEpisode{
+				Title:    stream.Raw.Host,
+				VideoURL: stream.URL,
+			})
+		}
 	}
-	defer res.Body.Close()
 
-	doc, err := goquery.NewDocumentFromReader(res.Body)
-	if err != nil {
-		log.Println("Error parsing Pdrain HTML:", err)
-		return ""
+	return od_anime_entity.VideoSource{
+		Res:      quality,
+		DataList: dataList,
+	}, streams
+}
+
+// animeData reads one ".venz li" card shared by the home, ongoing and
+// completed lists. Completed cards show a score where ongoing ones show the
+// release day, so ReleaseDay stays empty for them.
+func animeData(e *colly.HTMLElement, thumbnail string) od_anime_entity.AnimeData {
+	raw := od_anime_entity.AnimeDataRaw{
+		LatestEp:    e.ChildText(".epz"),
+		UpdateAnime: e.ChildText(".epztipe"),
 	}
 
-	return doc.Find(`meta[name="twitter:player:stream"]`).AttrOr("content", "")
+	slug := animeSlug(e.ChildAttr(".thumb a", "href"))
+
+	return od_anime_entity.AnimeData{
+		Title:        e.ChildText(".jdlflm"),
+		URL:          e.ChildAttr(".thumb a", "href"),
+		AnimeSlug:    slug,
+		JudulPath:    slug,
+		ThumbnailURL: e.ChildAttr(thumbnail, "src"),
+		LatestEp:     ParseCount(raw.LatestEp),
+		ReleaseDay:   ParseReleaseDay(raw.UpdateAnime),
+		Raw:          raw,
+	}
+}
+
+// episodeLink reads an upstream episode link matched by selector.
+func episodeLink(e *colly.HTMLElement, selector string) od_anime_entity.AnimeEpisode {
+	return od_anime_entity.AnimeEpisode{
+		Title:       e.ChildText(selector),
+		VideoURL:    e.ChildAttr(selector, "href"),
+		EpisodeSlug: episodeSlug(e.ChildAttr(selector, "href")),
+	}
 }
 
-// func main() {
-// // Test scrapeHomePage
-// home := ScrapeHomePage()
-// fmt.Println("\n== Home Page ==")
-// for _, a := range home {
-// 	fmt.Printf("%s | %s\n", a.Title, a.URL)
-// }
-
-// // Test scrapeod_anime_entity.GenreAnimes
-// od_anime_entity.GenreAnimes := Scrapeod_anime_entity.GenreAnimes("https://otakudesu.cloud/genre/romance/")
-// fmt.Println("\n== Genre Animes ==")
-// for _, g := range od_anime_entity.GenreAnimes {
-// 	fmt.Printf("%s | %s\n", g.Title, g.Links)
-// }
-
-// Test scrapeod_anime_entity.AnimeEpisodes
-// detail, episodes := ScrapeAnimeEpisodes("https://otakudesu.cloud/anime/zatsu-tabi-journey-sub-indo")
-// fmt.Println("\n== Anime Detail ==")
-// fmt.Printf("Title: %s\nEpisodes: %d\n", detail.Title, len(episodes))
-// for _, e := range episodes {
-// 	fmt.Printf("Ep: %s | %s\n", e.Title, e.VideoURL)
-// }
-
-// sourceData := scrapeAnimeSourceData("https://otakudesu.cloud/episode/zttj-episode-2-sub-indo/")
-
-// fmt.Println("\n== Anime Source Data ==")
-// fmt.Println(sourceData)
-
-// Test scrapeSearchAnimeByTitle
-// od_anime_entity.SearchResults := ScrapeSearchAnimeByTitle("https://otakudesu.cloud/?s=one+piece")
-// fmt.Println("\n== Search Result ==")
-// for _, s := range od_anime_entity.SearchResults {
-// 	fmt.Printf("%s | %s\n", s.Title, s.AnimeLinks)
-// }
-
-// // Test scrapeOngoingAnime
-// ongoing := ScrapeOngoingAnime("https://otakudesu.cloud/ongoing-anime/")
-// fmt.Println("\n== Ongoing Anime ==")
-// for _, o := range ongoing {
-// 	fmt.Printf("%s | %s\n", o.Title, o.URL)
-// }
-
-// 	fmt.Println("\n== DONE TESTING ==")
-// }
+func genreInfo(s *goquery.Selection) od_anime_entity.GenreInfo {
+	return od_anime_entity.GenreInfo{
+		Title: s.Text(),
+		URL:   s.AttrOr("href", ""),
+		Slug:  genreSlug(s.AttrOr("href", "")),
+	}
+}
+
+// setValue returns the value of a search result ".set" row, e.g. "Ongoing"
+// for <div class="set"><b>Status</b> : Ongoing</div>.
+func setValue(e *colly.HTMLElement, label string) string {
+	text := e.ChildText(".set:contains('" + label + "')")
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(text, label), " :"))
+}
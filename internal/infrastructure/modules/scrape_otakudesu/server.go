@@ -0,0 +1,212 @@
+package modules
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/gocolly/colly"
+)
+
+// Episode pages list their streaming servers as mirror buttons whose player
+// is fetched from WordPress' admin-ajax endpoint: one action hands out a
+// nonce, the other returns the player HTML for a mirror. The action names
+// come from the site's player script.
+const (
+	ajaxPath          = "/wp-admin/admin-ajax.php"
+	nonceAction       = "aa1208d27f29ca340c92c66d1926f13f"
+	embedAction       = "2a3505c93b0035d3f455df82bf976b84"
+	maxServerResolves = 4
+)
+
+// mirrorPayload is the base64 JSON a mirror button carries in data-content,
+// e.g. {"id":160862,"i":0,"q":"360p"}: the episode post, the server's
+// position within its quality and the quality.
+type mirrorPayload struct {
+	Post    int    `json:"id"`
+	Index   int    `json:"i"`
+	Quality string `json:"q"`
+}
+
+type streamMirror struct {
+	server  od_anime_entity.StreamServer
+	payload mirrorPayload
+}
+
+// ServerID names the index-th server of quality, e.g. "720p-1".
+func ServerID(quality string, index int) string {
+	return quality + "-" + strconv.Itoa(index)
+}
+
+// onStreamMirrors collects the mirror buttons of an episode page in page
+// order, skipping buttons whose payload can't be read.
+func onStreamMirrors(c *colly.Collector, mirrors *[]streamMirror) {
+	c.OnHTML(".mirrorstream ul li a[data-content]", func(e *colly.HTMLElement) {
+		raw, err := base64.StdEncoding.DecodeString(e.Attr("data-content"))
+		if err != nil {
+			return
+		}
+
+		var payload mirrorPayload
+		if err := json.Unmarshal(raw, &payload); err != nil || payload.Quality == "" {
+			return
+		}
+
+		*mirrors = append(*mirrors, streamMirror{
+			server: od_anime_entity.StreamServer{
+				ID:             ServerID(payload.Quality, payload.Index),
+				Quality:        payload.Quality,
+				Server:         strings.TrimSpace(e.Text),
+				ResolverStatus: od_anime_entity.ResolverFailed,
+			},
+			payload: payload,
+		})
+	})
+}
+
+// ajaxURL returns the admin-ajax endpoint of the site serving pageURL.
+func ajaxURL(pageURL string) string {
+	parsed, err := url.Parse(pageURL)
+	if err != nil {
+		return ajaxPath
+	}
+	return parsed.Scheme + "://" + parsed.Host + ajaxPath
+}
+
+// resolveServers fetches the embed URL of every mirror, a few at a time.
+// Servers that can't be resolved keep an empty EmbedURL and the failed status.
+func resolveServers(ctx context.Context, client *http.Client, pageURL string, mirrors []streamMirror) []od_anime_entity.StreamServer {
+	servers := make([]od_anime_entity.StreamServer, len(mirrors))
+	for i, mirror := range mirrors {
+		servers[i] = mirror.server
+	}
+	if len(mirrors) == 0 {
+		return servers
+	}
+
+	endpoint := ajaxURL(pageURL)
+	nonce, err := fetchNonce(ctx, client, endpoint)
+	if err != nil {
+		return servers
+	}
+
+	slots := make(chan struct{}, maxServerResolves)
+	var wg sync.WaitGroup
+
+	for i, mirror := range mirrors {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(server *od_anime_entity.StreamServer, payload mirrorPayload) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			if embed, err := fetchEmbed(ctx, client, endpoint, nonce, payload); err == nil {
+				server.EmbedURL = embed
+				server.ResolverStatus = od_anime_entity.ResolverResolved
+			}
+		}(&servers[i], mirror.payload)
+	}
+
+	wg.Wait()
+	return servers
+}
+
+// ScrapeStreamServer resolves the server with serverID on the episode page at
+// url, reporting ErrServerNotFound when the page has no such server.
+func ScrapeStreamServer(ctx context.Context, client *http.Client, url, serverID string) (od_anime_entity.StreamServer, error) {
+	c := newCollector(ctx, client)
+	var mirrors []streamMirror
+	onStreamMirrors(c, &mirrors)
+
+	if err := visit(ctx, c, "ScrapeStreamServer", url, ".venutama"); err != nil {
+		return od_anime_entity.StreamServer{}, err
+	}
+
+	for _, mirror := range mirrors {
+		if mirror.server.ID != serverID {
+			continue
+		}
+
+		endpoint := ajaxURL(url)
+		nonce, err := fetchNonce(ctx, client, endpoint)
+		if err != nil {
+			return od_anime_entity.StreamServer{}, err
+		}
+
+		embed, err := fetchEmbed(ctx, client, endpoint, nonce, mirror.payload)
+		if err != nil {
+			return od_anime_entity.StreamServer{}, err
+		}
+
+		server := mirror.server
+		server.EmbedURL = embed
+		server.ResolverStatus = od_anime_entity.ResolverResolved
+		return server, nil
+	}
+
+	return od_anime_entity.StreamServer{}, od_anime_entity.ErrServerNotFound
+}
+
+func fetchNonce(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	return ajaxData(ctx, client, "FetchNonce", endpoint, map[string]string{"action": nonceAction})
+}
+
+// fetchEmbed returns the src of the player iframe the site serves for payload.
+func fetchEmbed(ctx context.Context, client *http.Client, endpoint, nonce string, payload mirrorPayload) (string, error) {
+	data, err := ajaxData(ctx, client, "FetchEmbed", endpoint, map[string]string{
+		"id":     strconv.Itoa(payload.Post),
+		"i":      strconv.Itoa(payload.Index),
+		"q":      payload.Quality,
+		"nonce":  nonce,
+		"action": embedAction,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	layoutChanged := &od_anime_entity.ScrapeError{Op: "FetchEmbed", URL: endpoint, Err: od_anime_entity.ErrLayoutChanged}
+
+	player, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", layoutChanged
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(player)))
+	if err != nil {
+		return "", layoutChanged
+	}
+
+	src := strings.TrimSpace(doc.Find("iframe").AttrOr("src", ""))
+	if src == "" {
+		return "", layoutChanged
+	}
+	return src, nil
+}
+
+// ajaxData posts form to the admin-ajax endpoint and returns the "data" field
+// of its {"data": ...} reply. Any other reply, such as WordPress' "0" for an
+// unknown action, means the player script changed.
+func ajaxData(ctx context.Context, client *http.Client, op, endpoint string, form map[string]string) (string, error) {
+	body, err := post(ctx, newCollector(ctx, client), op, endpoint, form)
+	if err != nil {
+		return "", err
+	}
+
+	var reply struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil || reply.Data == "" {
+		return "", &od_anime_entity.ScrapeError{Op: op, URL: endpoint, Err: od_anime_entity.ErrLayoutChanged}
+	}
+	return reply.Data, nil
+}
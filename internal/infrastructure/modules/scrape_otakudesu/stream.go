@@ -0,0 +1,85 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
+)
+
+// streamSource parses one mirror link of a download row. The format comes
+// from the quality label and falls back to the link's file extension. Hosts
+// put file names in landing page URLs too, so a mirror only counts as direct
+// once a resolver has produced its file URL.
+func streamSource(quality, host, size, link string) od_anime_entity.StreamSource {
+	format := ParseFormat(quality)
+	if format == "" {
+		format = ParseFormat(path.Ext(urlPath(link)))
+	}
+
+	return od_anime_entity.StreamSource{
+		Resolution:     ParseResolution(quality),
+		Format:         format,
+		SizeBytes:      ParseSize(size),
+		Host:           strings.ToLower(host),
+		URL:            link,
+		ResolverStatus: od_anime_entity.ResolverUnsupported,
+		Raw: od_anime_entity.StreamSourceRaw{
+			Quality: quality,
+			Host:    host,
+			Size:    size,
+			URL:     link,
+		},
+	}
+}
+
+// resolveStream replaces stream's landing page with the direct URL found by
+// its host's resolver and records the outcome in ResolverStatus.
+func resolveStream(ctx context.Context, resolvers *resolver.Registry, stream *od_anime_entity.StreamSource) {
+	direct, err := resolvers.Resolve(ctx, stream.Raw.Host, stream.URL)
+	switch {
+	case errors.Is(err, resolver.ErrNoResolver):
+		stream.ResolverStatus = od_anime_entity.ResolverUnsupported
+	case err != nil:
+		stream.ResolverStatus = od_anime_entity.ResolverFailed
+	default:
+		stream.URL = direct
+		stream.Direct = true
+		stream.ResolverStatus = od_anime_entity.ResolverResolved
+		if stream.Format == "" {
+			stream.Format = ParseFormat(path.Ext(urlPath(direct)))
+		}
+	}
+}
+
+func urlPath(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Path
+}
+
+// SortStreams orders streams best playable first: direct URLs before landing
+// pages, then higher resolutions first. Ties keep their page order.
+func SortStreams(streams []od_anime_entity.StreamSource) []od_anime_entity.StreamSource {
+	sort.SliceStable(streams, func(i, j int) bool {
+		if streams[i].Direct != streams[j].Direct {
+			return streams[i].Direct
+		}
+		return resolution(streams[i]) > resolution(streams[j])
+	})
+	return streams
+}
+
+func resolution(stream od_anime_entity.StreamSource) int {
+	if stream.Resolution == nil {
+		return 0
+	}
+	return *stream.Resolution
+}
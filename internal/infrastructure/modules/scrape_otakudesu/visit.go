@@ -0,0 +1,127 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
+
+	"github.com/gocolly/colly"
+)
+
+// newCollector returns a collector that sends its requests through the shared
+// scraper client (transport, cookie jar and timeout) bound to ctx, so a
+// cancelled or expired ctx aborts the in-flight upstream fetch.
+func newCollector(ctx context.Context, client *http.Client, options ...func(*colly.Collector)) *colly.Collector {
+	c := colly.NewCollector(options...)
+	c.WithTransport(&contextTransport{ctx: ctx, next: client.Transport})
+	c.SetRequestTimeout(client.Timeout)
+
+	if jar, ok := client.Jar.(*cookiejar.Jar); ok {
+		c.SetCookieJar(jar)
+	}
+
+	return c
+}
+
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// visit fetches url with c and converts the outcome into a typed ScrapeError.
+// marker is a selector every well-formed page contains; when it never matches
+// the upstream layout is assumed to have changed.
+func visit(ctx context.Context, c *colly.Collector, op, url, marker string) error {
+	var (
+		statusCode  int
+		fetchErr    error
+		markerFound bool
+	)
+
+	c.OnHTML(marker, func(_ *colly.HTMLElement) {
+		markerFound = true
+	})
+
+	c.OnError(func(r *colly.Response, err error) {
+		fetchErr = err
+		if r != nil {
+			statusCode = r.StatusCode
+		}
+	})
+
+	if err := c.Visit(url); err != nil && fetchErr == nil {
+		fetchErr = err
+	}
+	c.Wait()
+
+	if err := scrapeFailure(ctx, op, url, statusCode, fetchErr); err != nil {
+		return err
+	}
+	if !markerFound {
+		return &od_anime_entity.ScrapeError{Op: op, URL: url, StatusCode: statusCode, Err: od_anime_entity.ErrLayoutChanged}
+	}
+	return nil
+}
+
+// post submits form to url with c and returns the response body, with
+// failures typed like visit's.
+func post(ctx context.Context, c *colly.Collector, op, url string, form map[string]string) ([]byte, error) {
+	var (
+		statusCode int
+		fetchErr   error
+		body       []byte
+	)
+
+	c.OnResponse(func(r *colly.Response) {
+		body = r.Body
+	})
+
+	c.OnError(func(r *colly.Response, err error) {
+		fetchErr = err
+		if r != nil {
+			statusCode = r.StatusCode
+		}
+	})
+
+	if err := c.Post(url, form); err != nil && fetchErr == nil {
+		fetchErr = err
+	}
+	c.Wait()
+
+	if err := scrapeFailure(ctx, op, url, statusCode, fetchErr); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// scrapeFailure classifies a failed fetch, returning nil when it succeeded.
+func scrapeFailure(ctx context.Context, op, url string, statusCode int, fetchErr error) error {
+	scrapeErr := &od_anime_entity.ScrapeError{Op: op, URL: url, StatusCode: statusCode}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		scrapeErr.Err = od_anime_entity.ErrTimeout
+	case ctx.Err() != nil:
+		scrapeErr.Err = ctx.Err()
+	case statusCode == http.StatusNotFound:
+		scrapeErr.Err = od_anime_entity.ErrNotFound
+	case statusCode != 0 && (statusCode < 200 || statusCode > 299):
+		scrapeErr.Err = od_anime_entity.ErrUpstreamStatus
+	case errors.Is(fetchErr, httpclient.ErrCircuitOpen):
+		scrapeErr.Err = od_anime_entity.ErrCircuitOpen
+	case fetchErr != nil:
+		scrapeErr.Err = od_anime_entity.ErrUpstreamUnreachable
+	default:
+		return nil
+	}
+
+	return scrapeErr
+}
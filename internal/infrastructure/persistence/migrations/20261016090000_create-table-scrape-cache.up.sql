@@ -0,0 +1,8 @@
+CREATE TABLE scrape_cache(
+    key             VARCHAR(512)    PRIMARY KEY,
+    value           BYTEA           NOT NULL,
+    stored_at       TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    expires_at      TIMESTAMP       NOT NULL
+);
+
+CREATE INDEX idx_scrape_cache_expires_at ON scrape_cache(expires_at);
@@ -0,0 +1,11 @@
+CREATE TABLE anime_index(
+    provider        VARCHAR(64)     NOT NULL,
+    anime_slug      VARCHAR(255)    NOT NULL,
+    title           VARCHAR(512)    NOT NULL,
+    url             VARCHAR(1024)   NOT NULL,
+    letter          VARCHAR(1)      NOT NULL,
+    updated_at      TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    PRIMARY KEY (provider, anime_slug)
+);
+
+CREATE INDEX idx_anime_index_letter ON anime_index(provider, letter, title);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS anime_studios;
+DROP TABLE IF EXISTS anime_genres;
+DROP TABLE IF EXISTS studios;
+DROP TABLE IF EXISTS genres;
+DROP TABLE IF EXISTS episodes;
+DROP TABLE IF EXISTS animes;
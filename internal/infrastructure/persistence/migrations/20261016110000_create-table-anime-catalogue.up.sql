@@ -0,0 +1,66 @@
+CREATE TABLE animes(
+    id                  UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider            VARCHAR(64)     NOT NULL,
+    slug                VARCHAR(255)    NOT NULL,
+    title               VARCHAR(512)    NOT NULL,
+    thumbnail_url       VARCHAR(1024)   NOT NULL,
+    synopsis            TEXT            NOT NULL,
+    rating              NUMERIC(4, 2),
+    producer            VARCHAR(512)    NOT NULL,
+    status              VARCHAR(16)     NOT NULL,
+    total_eps           INTEGER,
+    duration_minutes    INTEGER,
+    release_date        DATE,
+    batch_slug          VARCHAR(255)    NOT NULL,
+    synced_at           TIMESTAMP       NOT NULL,
+    created_at          TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    updated_at          TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    CONSTRAINT idx_animes_provider_slug UNIQUE (provider, slug)
+);
+
+CREATE INDEX idx_animes_synced_at ON animes(provider, synced_at);
+
+CREATE TABLE episodes(
+    id                  UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    anime_id            UUID            NOT NULL,
+    provider            VARCHAR(64)     NOT NULL,
+    slug                VARCHAR(255)    NOT NULL,
+    title               VARCHAR(512)    NOT NULL,
+    url                 VARCHAR(1024)   NOT NULL,
+    number              INTEGER,
+    position            INTEGER         NOT NULL,
+    created_at          TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    updated_at          TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    CONSTRAINT idx_episodes_provider_slug UNIQUE (provider, slug),
+    CONSTRAINT fk_anime
+        FOREIGN KEY (anime_id) REFERENCES animes(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_episodes_anime ON episodes(anime_id, position);
+
+CREATE TABLE genres(
+    id                  UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug                VARCHAR(255)    NOT NULL UNIQUE,
+    title               VARCHAR(255)    NOT NULL
+);
+
+CREATE TABLE studios(
+    id                  UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name                VARCHAR(255)    NOT NULL UNIQUE
+);
+
+CREATE TABLE anime_genres(
+    anime_id            UUID            NOT NULL REFERENCES animes(id) ON DELETE CASCADE,
+    genre_id            UUID            NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+    PRIMARY KEY (anime_id, genre_id)
+);
+
+CREATE INDEX idx_anime_genres_genre ON anime_genres(genre_id);
+
+CREATE TABLE anime_studios(
+    anime_id            UUID            NOT NULL REFERENCES animes(id) ON DELETE CASCADE,
+    studio_id           UUID            NOT NULL REFERENCES studios(id) ON DELETE CASCADE,
+    PRIMARY KEY (anime_id, studio_id)
+);
+
+CREATE INDEX idx_anime_studios_studio ON anime_studios(studio_id);
@@ -0,0 +1,12 @@
+CREATE TABLE job_runs(
+    id              UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    job             VARCHAR(64)     NOT NULL,
+    status          VARCHAR(16)     NOT NULL,
+    error           TEXT            NOT NULL,
+    started_at      TIMESTAMP       NOT NULL,
+    finished_at     TIMESTAMP,
+    duration_ms     BIGINT          NOT NULL
+);
+
+CREATE INDEX idx_job_runs_job_started ON job_runs(job, started_at);
+CREATE INDEX idx_job_runs_started ON job_runs(started_at);
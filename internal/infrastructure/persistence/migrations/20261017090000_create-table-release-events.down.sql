@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS release_states;
+DROP TABLE IF EXISTS release_events;
@@ -0,0 +1,24 @@
+CREATE TABLE release_events(
+    id              UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    seq             BIGSERIAL       NOT NULL,
+    type            VARCHAR(32)     NOT NULL,
+    provider        VARCHAR(64)     NOT NULL,
+    anime_slug      VARCHAR(255)    NOT NULL,
+    episode         INTEGER         NOT NULL,
+    title           VARCHAR(512)    NOT NULL,
+    url             VARCHAR(1024)   NOT NULL,
+    thumbnail_url   VARCHAR(1024)   NOT NULL,
+    detected_at     TIMESTAMP       NOT NULL,
+    CONSTRAINT idx_release_events_episode UNIQUE (provider, anime_slug, episode),
+    CONSTRAINT idx_release_events_seq UNIQUE (seq)
+);
+
+CREATE INDEX idx_release_events_detected_at ON release_events(detected_at);
+
+CREATE TABLE release_states(
+    provider        VARCHAR(64)     NOT NULL,
+    anime_slug      VARCHAR(255)    NOT NULL,
+    latest_ep       INTEGER         NOT NULL,
+    updated_at      TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    PRIMARY KEY (provider, anime_slug)
+);
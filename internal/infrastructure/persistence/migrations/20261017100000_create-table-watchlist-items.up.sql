@@ -0,0 +1,16 @@
+CREATE TABLE watchlist_items(
+    id              UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id         UUID            NOT NULL,
+    provider        VARCHAR(64)     NOT NULL,
+    anime_slug      VARCHAR(255)    NOT NULL,
+    status          VARCHAR(32)     NOT NULL,
+    score           SMALLINT,
+    notes           TEXT            DEFAULT ''  NOT NULL,
+    created_at      TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    updated_at      TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    CONSTRAINT idx_watchlist_items_anime UNIQUE (user_id, provider, anime_slug),
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_watchlist_items_user_updated ON watchlist_items(user_id, updated_at DESC);
@@ -0,0 +1,22 @@
+CREATE TABLE history_entries(
+    id                  UUID            PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id             UUID            NOT NULL,
+    provider            VARCHAR(64)     NOT NULL,
+    episode_slug        VARCHAR(255)    NOT NULL,
+    anime_slug          VARCHAR(255)    NOT NULL,
+    title               VARCHAR(512)    NOT NULL,
+    episode             VARCHAR(255)    NOT NULL,
+    next_episode_slug   VARCHAR(255)    DEFAULT ''  NOT NULL,
+    looked_up_at        TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    position_seconds    INTEGER         NOT NULL,
+    duration_seconds    INTEGER         DEFAULT 0  NOT NULL,
+    finished            BOOLEAN         DEFAULT FALSE  NOT NULL,
+    watched_at          TIMESTAMP       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    CONSTRAINT idx_history_entries_episode UNIQUE (user_id, provider, episode_slug),
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_history_entries_user_watched ON history_entries(user_id, watched_at DESC);
+CREATE INDEX idx_history_entries_user_anime ON history_entries(user_id, provider, anime_slug, watched_at DESC);
+CREATE INDEX idx_history_entries_watched_at ON history_entries(watched_at);
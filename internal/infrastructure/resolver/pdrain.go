@@ -0,0 +1,55 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const PdrainHost = "pixeldrain.com"
+
+type pdrainResolver struct{}
+
+// NewPdrainResolver resolves pixeldrain file pages through the stream URL
+// they advertise for embedded players.
+func NewPdrainResolver() LinkResolver {
+	return pdrainResolver{}
+}
+
+func (pdrainResolver) Name() string {
+	return "pdrain"
+}
+
+func (pdrainResolver) Hosts() []string {
+	return []string{PdrainHost}
+}
+
+func (pdrainResolver) Resolve(ctx context.Context, client *http.Client, pageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return "", fmt.Errorf("pdrain page %s: status %d", pageURL, res.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	stream := doc.Find(`meta[name="twitter:player:stream"]`).AttrOr("content", "")
+	if stream == "" {
+		return "", ErrNoStream
+	}
+	return stream, nil
+}
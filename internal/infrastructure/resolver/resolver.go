@@ -0,0 +1,158 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/cache"
+)
+
+var (
+	ErrNoResolver = errors.New("no resolver for mirror")
+	ErrNoStream   = errors.New("mirror page has no stream")
+)
+
+// LinkResolver turns a mirror host's landing page into a direct file URL.
+// Name is the mirror name upstream pages show for the host (e.g. "pdrain")
+// and Hosts the hostnames its landing pages are served from.
+type LinkResolver interface {
+	Name() string
+	Hosts() []string
+	Resolve(ctx context.Context, client *http.Client, pageURL string) (string, error)
+}
+
+// Options bound how a registered resolver is used. Zero values fall back to
+// DefaultOptions.
+type Options struct {
+	MaxConcurrent int
+	Timeout       time.Duration
+	CacheTTL      time.Duration
+	CacheSize     int
+}
+
+var DefaultOptions = Options{
+	MaxConcurrent: 4,
+	Timeout:       10 * time.Second,
+	CacheTTL:      time.Hour,
+	CacheSize:     1000,
+}
+
+type registered struct {
+	resolver LinkResolver
+	opts     Options
+	slots    chan struct{}
+	cache    cache.Store
+}
+
+// Registry picks the LinkResolver for a mirror link and runs it through the
+// shared scraper client, limiting concurrent resolves and caching results
+// per resolver.
+type Registry struct {
+	mu     sync.RWMutex
+	client *http.Client
+	hosts  map[string]*registered
+	names  map[string]*registered
+}
+
+func NewRegistry(client *http.Client) *Registry {
+	return &Registry{
+		client: client,
+		hosts:  make(map[string]*registered),
+		names:  make(map[string]*registered),
+	}
+}
+
+// Register adds resolver under each of its hosts and its name, replacing any
+// resolver registered for them before.
+func (r *Registry) Register(resolver LinkResolver, opts Options) {
+	if opts.MaxConcurrent < 1 {
+		opts.MaxConcurrent = DefaultOptions.MaxConcurrent
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultOptions.Timeout
+	}
+	if opts.CacheSize < 1 {
+		opts.CacheSize = DefaultOptions.CacheSize
+	}
+
+	entry := &registered{
+		resolver: resolver,
+		opts:     opts,
+		slots:    make(chan struct{}, opts.MaxConcurrent),
+		cache:    cache.NewLRUStore(opts.CacheSize),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, host := range resolver.Hosts() {
+		r.hosts[strings.ToLower(host)] = entry
+	}
+	r.names[strings.ToLower(resolver.Name())] = entry
+}
+
+// Supports reports whether a resolver would be used for the mirror.
+func (r *Registry) Supports(mirror, pageURL string) bool {
+	return r.lookup(mirror, pageURL) != nil
+}
+
+// lookup matches the link's host (or a parent domain of it) first. Sites often
+// link mirrors through their own redirect pages, so it falls back to the
+// mirror name shown on the page.
+func (r *Registry) lookup(mirror, pageURL string) *registered {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if parsed, err := url.Parse(pageURL); err == nil {
+		host := strings.ToLower(parsed.Hostname())
+		for host != "" {
+			if entry, ok := r.hosts[host]; ok {
+				return entry
+			}
+			_, host, _ = strings.Cut(host, ".")
+		}
+	}
+
+	return r.names[strings.ToLower(strings.TrimSpace(mirror))]
+}
+
+// Resolve returns the direct URL behind pageURL. It returns ErrNoResolver when
+// no resolver handles the mirror.
+func (r *Registry) Resolve(ctx context.Context, mirror, pageURL string) (string, error) {
+	entry := r.lookup(mirror, pageURL)
+	if entry == nil {
+		return "", ErrNoResolver
+	}
+
+	key := entry.resolver.Name() + ":" + pageURL
+	if cached, err := entry.cache.Get(ctx, key); err == nil {
+		return string(cached.Value), nil
+	}
+
+	select {
+	case entry.slots <- struct{}{}:
+		defer func() { <-entry.slots }()
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	resolveCtx, cancel := context.WithTimeout(ctx, entry.opts.Timeout)
+	defer cancel()
+
+	direct, err := entry.resolver.Resolve(resolveCtx, r.client, pageURL)
+	if err != nil {
+		return "", err
+	}
+
+	if entry.opts.CacheTTL > 0 {
+		now := time.Now()
+		_ = entry.cache.Set(ctx, key, &cache.Entry{Value: []byte(direct), StoredAt: now, ExpiresAt: now.Add(entry.opts.CacheTTL)})
+	}
+
+	return direct, nil
+}
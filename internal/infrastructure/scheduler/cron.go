@@ -0,0 +1,212 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record an unrestricted day field: when both day
+	// fields are restricted a day matching either one runs, as in cron(8).
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Sunday is both 0 and 7.
+	dowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse reads a five-field cron expression (minute, hour, day of month,
+// month, day of week) or one of the @hourly style descriptors. Fields take
+// "*", values, ranges ("1-5"), steps ("*/15", "0-30/10") and comma lists;
+// months and weekdays also take three-letter English names.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expr, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = expr
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("cron %q: want 5 fields, got %d", spec, len(fields))
+	}
+
+	var s Schedule
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return Schedule{}, fmt.Errorf("cron %q: minute: %w", spec, err)
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return Schedule{}, fmt.Errorf("cron %q: hour: %w", spec, err)
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return Schedule{}, fmt.Errorf("cron %q: day of month: %w", spec, err)
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return Schedule{}, fmt.Errorf("cron %q: month: %w", spec, err)
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return Schedule{}, fmt.Errorf("cron %q: day of week: %w", spec, err)
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+func (f cronField) parse(field string) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		bits, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+func (f cronField) parsePart(part string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid step %q", stepPart)
+		}
+	}
+
+	var low, high int
+	switch {
+	case rangePart == "*":
+		low, high = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if low, err = f.value(lowPart); err != nil {
+			return 0, err
+		}
+		if high, err = f.value(highPart); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid range %q", rangePart)
+		}
+	default:
+		var err error
+		if low, err = f.value(rangePart); err != nil {
+			return 0, err
+		}
+		// "5/15" runs from 5 to the end of the field.
+		high = low
+		if hasStep {
+			high = f.max
+		}
+	}
+
+	var set uint64
+	for v := low; v <= high; v += step {
+		set |= 1 << v
+	}
+	return set, nil
+}
+
+func (f cronField) value(text string) (int, error) {
+	if v, ok := f.names[strings.ToLower(text)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(text)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %q out of range %d-%d", text, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t the schedule runs, in t's location, or
+// the zero time when it never runs (e.g. "0 0 30 2 *").
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + 5
+
+	// Each loop moves to the start of the next matching unit; rolling over
+	// into a larger unit rechecks from the month down.
+wrap:
+	if t.Year() > limit {
+		return time.Time{}
+	}
+
+	for s.month&(1<<uint(t.Month())) == 0 {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for s.hour&(1<<uint(t.Hour())) == 0 {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.minute&(1<<uint(t.Minute())) == 0 {
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,260 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/job_run"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/sirupsen/logrus"
+)
+
+// historyTimeout bounds writing a run's history, which also happens after the
+// scheduler was stopped.
+const historyTimeout = 5 * time.Second
+
+// ErrAlreadyStarted is returned by Add once the scheduler runs.
+var ErrAlreadyStarted = errors.New("scheduler already started")
+
+// History stores job runs. A run is created when it starts and updated when
+// it ends.
+type History interface {
+	CreateJobRun(ctx context.Context, run *model.JobRun) error
+	UpdateJobRun(ctx context.Context, run *model.JobRun) error
+}
+
+// Options configure a Scheduler. MaxConcurrent below 1 lets every job run at
+// once. Each run is delayed by a random duration up to Jitter, so jobs due at
+// the same minute don't hit upstreams together.
+type Options struct {
+	MaxConcurrent int
+	Jitter        time.Duration
+}
+
+// Scheduler runs jobs on cron schedules in the background. A job never
+// overlaps itself: when a run outlasts its interval the missed runs are
+// skipped.
+type Scheduler struct {
+	Log     *logrus.Logger
+	History History
+	Jitter  time.Duration
+	// Now returns the time schedules are evaluated against; replace it
+	// before Start to shift the clock.
+	Now func() time.Time
+
+	jobs  []*job
+	slots chan struct{}
+
+	mu      sync.Mutex
+	started bool
+	stop    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+type job struct {
+	name     string
+	schedule Schedule
+	run      func(ctx context.Context) error
+}
+
+func New(history History, opts Options) *Scheduler {
+	s := &Scheduler{
+		Log:     utils.Log,
+		History: history,
+		Jitter:  opts.Jitter,
+		Now:     time.Now,
+		stop:    make(chan struct{}),
+	}
+	if opts.MaxConcurrent > 0 {
+		s.slots = make(chan struct{}, opts.MaxConcurrent)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	return s
+}
+
+// Add registers run under name on the cron spec (see Parse). An empty spec
+// disables the job.
+func (s *Scheduler) Add(name, spec string, run func(ctx context.Context) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return ErrAlreadyStarted
+	}
+	if spec == "" {
+		s.Log.Infof("Job %s is disabled", name)
+		return nil
+	}
+
+	schedule, err := Parse(spec)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", name, err)
+	}
+
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, run: run})
+	return nil
+}
+
+// Start schedules the registered jobs. Calling it again does nothing.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+}
+
+// Stop stops scheduling runs and waits for the running ones to end. Once ctx
+// is done the running jobs are cancelled; Stop still waits for them to return
+// and then reports ctx's error.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+
+	for {
+		now := s.Now()
+		next := j.schedule.Next(now)
+		if next.IsZero() {
+			s.Log.Warnf("Job %s will never run again", j.name)
+			return
+		}
+
+		timer := time.NewTimer(next.Sub(now) + s.jitter())
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if !s.acquire() {
+			return
+		}
+		s.execute(j)
+		s.release()
+	}
+}
+
+func (s *Scheduler) jitter() time.Duration {
+	if s.Jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.Jitter)))
+}
+
+// acquire waits for a free run slot, reporting false when the scheduler
+// stopped first.
+func (s *Scheduler) acquire() bool {
+	select {
+	case <-s.stop:
+		return false
+	default:
+	}
+
+	if s.slots == nil {
+		return true
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+		return true
+	case <-s.stop:
+		return false
+	}
+}
+
+func (s *Scheduler) release() {
+	if s.slots != nil {
+		<-s.slots
+	}
+}
+
+// execute runs j once and records the run. Recording failures are logged but
+// don't keep the job from running.
+func (s *Scheduler) execute(j *job) {
+	run := &model.JobRun{
+		Job:       j.name,
+		Status:    model.JobRunRunning,
+		StartedAt: time.Now(),
+	}
+	s.record(j.name, run, s.History.CreateJobRun)
+
+	err := s.call(j)
+
+	finished := time.Now()
+	run.FinishedAt = &finished
+	run.DurationMS = finished.Sub(run.StartedAt).Milliseconds()
+	run.Status = model.JobRunSuccess
+	if err != nil {
+		run.Status = model.JobRunFailed
+		run.Error = err.Error()
+		s.Log.Errorf("Job %s failed after %dms: %+v", j.name, run.DurationMS, err)
+	} else {
+		s.Log.Infof("Job %s finished in %dms", j.name, run.DurationMS)
+	}
+
+	if run.ID != "" {
+		s.record(j.name, run, s.History.UpdateJobRun)
+	}
+}
+
+// call runs j, turning a panic into an error so one broken job can't take
+// the process down.
+func (s *Scheduler) call(j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return j.run(s.ctx)
+}
+
+func (s *Scheduler) record(name string, run *model.JobRun, save func(ctx context.Context, run *model.JobRun) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), historyTimeout)
+	defer cancel()
+
+	if err := save(ctx, run); err != nil {
+		s.Log.Errorf("Failed to record run of job %s: %+v", name, err)
+	}
+}
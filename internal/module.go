@@ -1,20 +1,40 @@
 package module
 
 import (
+	"context"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/muhammadsaefulr/NimeStreamAPI/config"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/router"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/cache"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
+	odScraper "github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/modules/scrape_otakudesu"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/scheduler"
+	animeRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/anime"
+	animeIndexRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/anime_index"
+	historyRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/history"
+	jobRunRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/job_run"
+	releaseRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/release"
 	userRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/user"
+	watchlistRepo "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/watchlist"
 	authService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/auth_service"
+	historyService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/history_service"
 	odService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	streamService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/stream_service"
 	systemService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/system_service"
 	userService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/user_service"
+	watchlistService "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/watchlist_service"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
 
 	"gorm.io/gorm"
 )
 
-func InitModule(app *fiber.App, db *gorm.DB) {
+// InitModule registers the API routes and returns the background job
+// scheduler unstarted; the caller starts it once the server is set up.
+func InitModule(app *fiber.App, db *gorm.DB) *scheduler.Scheduler {
 	validate := validation.Validator()
 
 	// Init services
@@ -25,16 +45,125 @@ func InitModule(app *fiber.App, db *gorm.DB) {
 
 	authSvc := authService.NewAuthService(db, validate, userSvc, tokenSvc)
 
+	// Scrape upstreams share one HTTP client with a circuit breaker per host
+	scrapeBreakers := httpclient.NewBreakerSet(config.ScrapeBreakerThreshold, time.Duration(config.ScrapeBreakerCooldown)*time.Second)
+	scrapeBreakers.Track("Otakudesu", odScraper.MainHost)
+	scrapeBreakers.Track("Pdrain", resolver.PdrainHost)
+
 	emailSvc := systemService.NewEmailService()
-	healthSvc := systemService.NewHealthCheckService(db)
+	healthSvc := systemService.NewHealthCheckService(db, scrapeBreakers)
+
+	// Anime source providers
+	scrapeClient, err := httpclient.New(scrapeClientConfig(scrapeBreakers))
+	if err != nil {
+		utils.Log.Fatalf("Failed to build scraper http client: %+v", err)
+	}
+
+	scrapeTimeout := time.Duration(config.ScrapeTimeout) * time.Second
+	linkResolvers := resolver.NewRegistry(scrapeClient)
+	linkResolvers.Register(resolver.NewPdrainResolver(), linkResolverOptions())
+
+	otakudesu := odScraper.NewProvider(odScraper.MainURL, scrapeClient, linkResolvers)
+
+	// Stream links must not be signed with a secret shared with other tokens.
+	if config.StreamTokenSecret == "" {
+		utils.Log.Fatal("STREAM_TOKEN_SECRET must be set")
+	}
+
+	// Direct streams are served through the stream proxy. Its client has no
+	// overall timeout, which would cut long videos off, and only dials public
+	// addresses since stream URLs come from scraped pages.
+	streamClient, err := httpclient.New(httpclient.Config{
+		ProxyURLs:           config.ScrapeProxyURLs,
+		UserAgents:          config.ScrapeUserAgents,
+		MaxIdleConnsPerHost: config.ScrapeMaxIdleConns,
+		PublicOnly:          true,
+	})
+	if err != nil {
+		utils.Log.Fatalf("Failed to build stream http client: %+v", err)
+	}
+	streamSvc := streamService.NewStreamService(streamClient, streamOptions())
 
-	animeSvc := odService.NewAnimeService()
+	cacheStore := newCacheStore(db)
+	cachedAnimeSvc := odService.NewCachedAnimeService(
+		odService.NewAnimeService(otakudesu, validate, scrapeTimeout),
+		cacheStore, odScraper.ProviderName, scrapeCacheTTL(), scrapeTimeout,
+	)
+
+	animeIndexSvc := odService.NewAnimeIndexService(
+		otakudesu, animeIndexRepo.NewAnimeIndexRepoImpl(db), validate, scrapeTimeout,
+	)
+	animeCatalogSvc := odService.NewAnimeCatalogService(otakudesu, animeRepo.NewAnimeRepoImpl(db), validate, catalogSync())
+
+	// Details and lists come from the catalogue once synced, scraping otherwise.
+	animeSvc := odService.NewStreamLinkedAnimeService(
+		odService.NewCataloguedAnimeService(cachedAnimeSvc, animeCatalogSvc), streamSvc,
+	)
+	releaseFeedSvc := odService.NewReleaseFeedService(odScraper.ProviderName, releaseRepo.NewReleaseRepoImpl(db), validate)
+	watchlistSvc := watchlistService.NewWatchlistService(odScraper.ProviderName, watchlistRepo.NewWatchlistRepoImpl(db), validate)
+	historySvc := historyService.NewHistoryService(odScraper.ProviderName, cachedAnimeSvc, historyRepo.NewHistoryRepoImpl(db), validate)
+
+	// Background work, started by the caller alongside the server.
+	jobRuns := jobRunRepo.NewJobRunRepoImpl(db)
+	jobs := scheduler.New(jobRuns, scheduler.Options{
+		MaxConcurrent: config.SchedulerMaxConcurrent,
+		Jitter:        time.Duration(config.SchedulerJitter) * time.Second,
+	})
+	// Each home and ongoing refresh is diffed for new episodes.
+	addJob(jobs, "home-refresh", config.JobHomeRefreshCron, func(ctx context.Context) error {
+		animes, err := cachedAnimeSvc.RefreshHomePage(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = releaseFeedSvc.Track(ctx, animes)
+		return err
+	})
+	addJob(jobs, "ongoing-refresh", config.JobOngoingRefreshCron, func(ctx context.Context) error {
+		ongoing, err := cachedAnimeSvc.RefreshOngoingAnime(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = releaseFeedSvc.Track(ctx, ongoing.Items)
+		return err
+	})
+	addJob(jobs, "schedule-refresh", config.JobScheduleRefreshCron, func(ctx context.Context) error {
+		_, err := cachedAnimeSvc.RefreshSchedule(ctx)
+		return err
+	})
+	addJob(jobs, "anime-index-refresh", config.JobAnimeIndexCron, func(ctx context.Context) error {
+		return animeIndexSvc.Refresh(ctx)
+	})
+	addJob(jobs, "catalog-sync", config.JobCatalogSyncCron, func(ctx context.Context) error {
+		_, err := animeCatalogSvc.Sync(ctx)
+		return err
+	})
+	addJob(jobs, "scrape-cache-prune", config.JobCachePruneCron, func(ctx context.Context) error {
+		_, err := cacheStore.DeleteExpired(ctx)
+		return err
+	})
+	addJob(jobs, "job-history-prune", config.JobHistoryPruneCron, func(ctx context.Context) error {
+		_, err := jobRuns.DeleteJobRunsBefore(ctx, time.Now().AddDate(0, 0, -config.JobHistoryRetentionDays))
+		return err
+	})
+	if config.WatchHistoryRetention > 0 {
+		addJob(jobs, "watch-history-prune", config.JobWatchHistoryCron, func(ctx context.Context) error {
+			_, err := historySvc.Prune(ctx, time.Now().AddDate(0, 0, -config.WatchHistoryRetention))
+			return err
+		})
+	}
+
+	animeProviders := odService.NewProviderRegistry()
+	animeProviders.Register(odScraper.ProviderName, animeSvc)
 
 	v1 := app.Group("/api/v1")
 
 	router.AuthRoutes(v1, authSvc, userSvc, tokenSvc, emailSvc)
 	router.UserRoutes(v1, userSvc, tokenSvc)
-	router.OdRoutes(v1, animeSvc)
+	router.MeRoutes(v1, userSvc, watchlistSvc, historySvc)
+	router.OdRoutes(v1, animeSvc, animeIndexSvc, animeProviders)
+	router.SourceRoutes(v1, animeProviders)
+	router.StreamRoutes(v1, streamSvc)
+	router.FeedRoutes(v1, releaseFeedSvc)
 	router.HealthCheckRoutes(v1, healthSvc)
 	router.DocsRoutes(v1)
 
@@ -43,4 +172,79 @@ func InitModule(app *fiber.App, db *gorm.DB) {
 			return c.SendString("API Docs here")
 		})
 	}
+
+	return jobs
+}
+
+// addJob schedules a job, treating a bad cron expression in the config as fatal.
+func addJob(jobs *scheduler.Scheduler, name, spec string, run func(ctx context.Context) error) {
+	if err := jobs.Add(name, spec, run); err != nil {
+		utils.Log.Fatalf("Failed to schedule job: %+v", err)
+	}
+}
+
+func newCacheStore(db *gorm.DB) cache.Store {
+	if config.CacheDriver == "postgres" {
+		return cache.NewPostgresStore(db)
+	}
+
+	return cache.NewLRUStore(config.CacheLRUSize)
+}
+
+func scrapeCacheTTL() odService.CacheTTL {
+	return odService.CacheTTL{
+		Home:      time.Duration(config.CacheTTLHome) * time.Second,
+		Detail:    time.Duration(config.CacheTTLDetail) * time.Second,
+		Episode:   time.Duration(config.CacheTTLEpisode) * time.Second,
+		Batch:     time.Duration(config.CacheTTLBatch) * time.Second,
+		Genre:     time.Duration(config.CacheTTLGenre) * time.Second,
+		Genres:    time.Duration(config.CacheTTLGenres) * time.Second,
+		Search:    time.Duration(config.CacheTTLSearch) * time.Second,
+		Ongoing:   time.Duration(config.CacheTTLOngoing) * time.Second,
+		Completed: time.Duration(config.CacheTTLCompleted) * time.Second,
+		Schedule:  time.Duration(config.CacheTTLSchedule) * time.Second,
+		Stale:     time.Duration(config.CacheStaleTTL) * time.Second,
+	}
+}
+
+func catalogSync() odService.CatalogSync {
+	return odService.CatalogSync{
+		StaleAfter:  time.Duration(config.CatalogStaleHours) * time.Hour,
+		MaxAge:      time.Duration(config.CacheTTLDetail) * time.Second,
+		Batch:       config.CatalogSyncBatch,
+		Concurrency: config.CatalogSyncConcurrency,
+		Timeout:     time.Duration(config.ScrapeTimeout) * time.Second,
+	}
+}
+
+func scrapeClientConfig(breakers *httpclient.BreakerSet) httpclient.Config {
+	return httpclient.Config{
+		ProxyURLs:           config.ScrapeProxyURLs,
+		UserAgents:          config.ScrapeUserAgents,
+		MaxRetries:          config.ScrapeMaxRetries,
+		RetryBaseDelay:      time.Duration(config.ScrapeRetryBaseMS) * time.Millisecond,
+		Timeout:             time.Duration(config.ScrapeTimeout) * time.Second,
+		MaxIdleConnsPerHost: config.ScrapeMaxIdleConns,
+		Breakers:            breakers,
+	}
+}
+
+func linkResolverOptions() resolver.Options {
+	return resolver.Options{
+		MaxConcurrent: config.ResolverMaxConcurrent,
+		Timeout:       time.Duration(config.ResolverTimeout) * time.Second,
+		CacheTTL:      time.Duration(config.ResolverCacheTTL) * time.Second,
+		CacheSize:     config.ResolverCacheSize,
+	}
+}
+
+func streamOptions() streamService.Options {
+	return streamService.Options{
+		Secret:        config.StreamTokenSecret,
+		BasePath:      "/api/v1/stream",
+		TokenTTL:      time.Duration(config.StreamTokenTTL) * time.Second,
+		MaxPerViewer:  config.StreamMaxPerUser,
+		IdleWindow:    time.Duration(config.StreamIdle) * time.Second,
+		HeaderTimeout: time.Duration(config.StreamTimeout) * time.Second,
+	}
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime"
+)
+
+type AnimeRepo interface {
+	GetAllAnime(ctx context.Context, provider string, param *request.QueryAnimeCatalog) ([]model.Anime, int64, error)
+	GetAnimeBySlug(ctx context.Context, provider, slug string) (*model.Anime, error)
+	UpsertAnime(ctx context.Context, anime *model.Anime) error
+	UnsyncedIndexSlugs(ctx context.Context, provider string, limit int) ([]string, error)
+	StaleAnimeSlugs(ctx context.Context, provider string, syncedBefore time.Time, limit int) ([]string, error)
+}
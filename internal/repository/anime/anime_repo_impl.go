@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime"
+	indexModel "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime_index"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// catalogSorts maps QueryAnimeCatalog.Sort onto ORDER BY clauses. Titles
+// break ties so pages are stable.
+var catalogSorts = map[string]string{
+	"title":        "LOWER(animes.title) asc",
+	"rating":       "animes.rating desc nulls last, LOWER(animes.title) asc",
+	"release_date": "animes.release_date desc nulls last, LOWER(animes.title) asc",
+	"updated":      "animes.synced_at desc, LOWER(animes.title) asc",
+}
+
+type animeRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewAnimeRepoImpl(db *gorm.DB) AnimeRepo {
+	return &animeRepoImpl{
+		DB: db,
+	}
+}
+
+// GetAllAnime implements AnimeRepo. Genres and studios are loaded, episodes
+// are not, and URL is taken from the A–Z index; param.Page and param.Limit
+// must already be defaulted.
+func (r *animeRepoImpl) GetAllAnime(ctx context.Context, provider string, param *request.QueryAnimeCatalog) ([]model.Anime, int64, error) {
+	var animes []model.Anime
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&model.Anime{}).Where("animes.provider = ?", provider)
+	if param.Search != "" {
+		query = query.Where("animes.title ILIKE ?", "%"+param.Search+"%")
+	}
+	if param.Status != "" {
+		query = query.Where("animes.status = ?", param.Status)
+	}
+	if param.Genre != "" {
+		query = query.Where("animes.id IN (?)", r.DB.Table("anime_genres").
+			Select("anime_genres.anime_id").
+			Joins("JOIN genres ON genres.id = anime_genres.genre_id").
+			Where("genres.slug = ?", param.Genre))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order, ok := catalogSorts[param.Sort]
+	if !ok {
+		order = catalogSorts["title"]
+	}
+
+	offset := (param.Page - 1) * param.Limit
+	err := query.Preload("Genres").Preload("Studios").
+		Select("animes.*, COALESCE(anime_index.url, '') AS url").
+		Joins("LEFT JOIN anime_index ON anime_index.provider = animes.provider AND anime_index.anime_slug = animes.slug").
+		Order(order).Limit(param.Limit).Offset(offset).
+		Find(&animes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return animes, total, nil
+}
+
+// GetAnimeBySlug implements AnimeRepo. Episodes are ordered as upstream lists
+// them.
+func (r *animeRepoImpl) GetAnimeBySlug(ctx context.Context, provider, slug string) (*model.Anime, error) {
+	anime := new(model.Anime)
+
+	err := r.DB.WithContext(ctx).
+		Preload("Genres").
+		Preload("Studios").
+		Preload("Episodes", func(db *gorm.DB) *gorm.DB { return db.Order("position asc") }).
+		Where("provider = ? AND slug = ?", provider, slug).
+		First(anime).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return anime, nil
+}
+
+// UpsertAnime implements AnimeRepo. The anime is matched on provider and slug;
+// its genres and studios are linked (and created when new) and its episode
+// list replaced, all in one transaction. anime.ID is set on return.
+func (r *animeRepoImpl) UpsertAnime(ctx context.Context, anime *model.Anime) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "provider"}, {Name: "slug"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"title", "thumbnail_url", "synopsis", "rating", "producer", "status", "total_eps",
+				"duration_minutes", "release_date", "batch_slug", "synced_at", "updated_at",
+			}),
+		}).Create(anime).Error
+		if err != nil {
+			return err
+		}
+
+		if err := r.linkGenres(tx, anime); err != nil {
+			return err
+		}
+		if err := r.linkStudios(tx, anime); err != nil {
+			return err
+		}
+		return r.replaceEpisodes(tx, anime)
+	})
+}
+
+func (r *animeRepoImpl) linkGenres(tx *gorm.DB, anime *model.Anime) error {
+	if err := tx.Where("anime_id = ?", anime.ID).Delete(&model.AnimeGenre{}).Error; err != nil {
+		return err
+	}
+	if len(anime.Genres) == 0 {
+		return nil
+	}
+
+	// Updating the title on conflict makes Postgres return the existing id.
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title"}),
+	}).Create(&anime.Genres).Error
+	if err != nil {
+		return err
+	}
+
+	links := make([]model.AnimeGenre, 0, len(anime.Genres))
+	for _, genre := range anime.Genres {
+		links = append(links, model.AnimeGenre{AnimeID: anime.ID, GenreID: genre.ID})
+	}
+	return tx.Create(&links).Error
+}
+
+func (r *animeRepoImpl) linkStudios(tx *gorm.DB, anime *model.Anime) error {
+	if err := tx.Where("anime_id = ?", anime.ID).Delete(&model.AnimeStudio{}).Error; err != nil {
+		return err
+	}
+	if len(anime.Studios) == 0 {
+		return nil
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}).Create(&anime.Studios).Error
+	if err != nil {
+		return err
+	}
+
+	links := make([]model.AnimeStudio, 0, len(anime.Studios))
+	for _, studio := range anime.Studios {
+		links = append(links, model.AnimeStudio{AnimeID: anime.ID, StudioID: studio.ID})
+	}
+	return tx.Create(&links).Error
+}
+
+// replaceEpisodes upserts the anime's episodes and removes the ones upstream
+// no longer lists. Episodes keep their id across syncs.
+func (r *animeRepoImpl) replaceEpisodes(tx *gorm.DB, anime *model.Anime) error {
+	if len(anime.Episodes) == 0 {
+		return tx.Where("anime_id = ?", anime.ID).Delete(&model.Episode{}).Error
+	}
+
+	slugs := make([]string, 0, len(anime.Episodes))
+	for i := range anime.Episodes {
+		anime.Episodes[i].AnimeID = anime.ID
+		anime.Episodes[i].Provider = anime.Provider
+		slugs = append(slugs, anime.Episodes[i].Slug)
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "provider"}, {Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"anime_id", "title", "url", "number", "position", "updated_at"}),
+	}).Create(&anime.Episodes).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Where("anime_id = ? AND slug NOT IN ?", anime.ID, slugs).Delete(&model.Episode{}).Error
+}
+
+// UnsyncedIndexSlugs implements AnimeRepo. It returns titles of the provider's
+// A–Z index that have no catalogue entry yet, in index order.
+func (r *animeRepoImpl) UnsyncedIndexSlugs(ctx context.Context, provider string, limit int) ([]string, error) {
+	var slugs []string
+
+	err := r.DB.WithContext(ctx).Model(&indexModel.AnimeIndex{}).
+		Where("provider = ?", provider).
+		Where("NOT EXISTS (SELECT 1 FROM animes WHERE animes.provider = anime_index.provider AND animes.slug = anime_index.anime_slug)").
+		Order("LOWER(title) asc").
+		Limit(limit).
+		Pluck("anime_slug", &slugs).Error
+
+	return slugs, err
+}
+
+// StaleAnimeSlugs implements AnimeRepo. Completed titles never go stale; the
+// rest are returned least recently synced first.
+func (r *animeRepoImpl) StaleAnimeSlugs(ctx context.Context, provider string, syncedBefore time.Time, limit int) ([]string, error) {
+	var slugs []string
+
+	err := r.DB.WithContext(ctx).Model(&model.Anime{}).
+		Where("provider = ? AND status <> ? AND synced_at < ?", provider, "completed", syncedBefore).
+		Order("synced_at asc").
+		Limit(limit).
+		Pluck("slug", &slugs).Error
+
+	return slugs, err
+}
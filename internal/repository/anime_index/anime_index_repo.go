@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime_index"
+)
+
+type AnimeIndexRepo interface {
+	GetAnimeIndex(ctx context.Context, provider string, param *request.QueryAnimeIndex) ([]model.AnimeIndex, int64, error)
+	CountAnimeIndex(ctx context.Context, provider string) (int64, error)
+	ReplaceAnimeIndex(ctx context.Context, provider string, entries []model.AnimeIndex) error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime_index"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const replaceBatchSize = 500
+
+type animeIndexRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewAnimeIndexRepoImpl(db *gorm.DB) AnimeIndexRepo {
+	return &animeIndexRepoImpl{
+		DB: db,
+	}
+}
+
+// GetAnimeIndex implements AnimeIndexRepo. Titles are ordered alphabetically;
+// param.Page and param.Limit must already be defaulted.
+func (r *animeIndexRepoImpl) GetAnimeIndex(ctx context.Context, provider string, param *request.QueryAnimeIndex) ([]model.AnimeIndex, int64, error) {
+	var entries []model.AnimeIndex
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&model.AnimeIndex{}).Where("provider = ?", provider)
+	if param.Letter != "" {
+		query = query.Where("letter = ?", param.Letter)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (param.Page - 1) * param.Limit
+	if err := query.Order("LOWER(title) asc").Limit(param.Limit).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// CountAnimeIndex implements AnimeIndexRepo.
+func (r *animeIndexRepoImpl) CountAnimeIndex(ctx context.Context, provider string) (int64, error) {
+	var total int64
+
+	err := r.DB.WithContext(ctx).Model(&model.AnimeIndex{}).Where("provider = ?", provider).Count(&total).Error
+	return total, err
+}
+
+// ReplaceAnimeIndex implements AnimeIndexRepo. It upserts entries and removes
+// the provider's titles that are no longer listed, in one transaction so
+// readers never see a half-built index.
+func (r *animeIndexRepoImpl) ReplaceAnimeIndex(ctx context.Context, provider string, entries []model.AnimeIndex) error {
+	refreshedAt := time.Now()
+	for i := range entries {
+		entries[i].Provider = provider
+		entries[i].UpdatedAt = refreshedAt
+	}
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(entries) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "provider"}, {Name: "anime_slug"}},
+				DoUpdates: clause.AssignmentColumns([]string{"title", "url", "letter", "updated_at"}),
+			}).CreateInBatches(entries, replaceBatchSize).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("provider = ? AND updated_at < ?", provider, refreshedAt).Delete(&model.AnimeIndex{}).Error
+	})
+}
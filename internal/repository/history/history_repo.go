@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+)
+
+type HistoryRepo interface {
+	GetHistory(ctx context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error)
+	GetContinueWatching(ctx context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error)
+	GetHistoryEntry(ctx context.Context, userID uuid.UUID, provider, episodeSlug string) (*model.HistoryEntry, error)
+	SaveHistoryEntry(ctx context.Context, entry *model.HistoryEntry) error
+	DeleteHistoryEntry(ctx context.Context, userID uuid.UUID, provider, episodeSlug string) error
+	DeleteUserHistoryBefore(ctx context.Context, userID uuid.UUID, provider string, before time.Time) (int64, error)
+	DeleteHistoryBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type historyRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewHistoryRepoImpl(db *gorm.DB) HistoryRepo {
+	return &historyRepoImpl{
+		DB: db,
+	}
+}
+
+// GetHistory implements HistoryRepo. Entries are returned most recently
+// watched first; param must already be defaulted.
+func (h *historyRepoImpl) GetHistory(ctx context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	query := h.DB.WithContext(ctx).Model(&model.HistoryEntry{}).
+		Where("user_id = ? AND provider = ?", userID, provider)
+
+	return paginate(query, param)
+}
+
+// GetContinueWatching implements HistoryRepo. It returns the last watched
+// episode of each anime, leaving out anime whose last episode was finished
+// with no next episode to go on with.
+func (h *historyRepoImpl) GetContinueWatching(ctx context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	latest := h.DB.Model(&model.HistoryEntry{}).
+		Select("DISTINCT ON (anime_slug) *").
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Order("anime_slug, watched_at desc")
+
+	query := h.DB.WithContext(ctx).Table("(?) AS history_entries", latest).
+		Where("NOT (finished AND next_episode_slug = '')")
+
+	return paginate(query, param)
+}
+
+func paginate(query *gorm.DB, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	var entries []model.HistoryEntry
+	var total int64
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (param.Page - 1) * param.Limit
+	if err := query.Order("watched_at desc").Limit(param.Limit).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// GetHistoryEntry implements HistoryRepo.
+func (h *historyRepoImpl) GetHistoryEntry(ctx context.Context, userID uuid.UUID, provider, episodeSlug string) (*model.HistoryEntry, error) {
+	entry := new(model.HistoryEntry)
+
+	result := h.DB.WithContext(ctx).
+		Where("user_id = ? AND provider = ? AND episode_slug = ?", userID, provider, episodeSlug).
+		First(entry)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return entry, nil
+}
+
+// SaveHistoryEntry implements HistoryRepo. It creates the user's entry for
+// the episode or overwrites the existing one; entry.ID is set on return.
+func (h *historyRepoImpl) SaveHistoryEntry(ctx context.Context, entry *model.HistoryEntry) error {
+	return h.DB.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "provider"}, {Name: "episode_slug"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"anime_slug", "title", "episode", "next_episode_slug", "looked_up_at",
+				"position_seconds", "duration_seconds", "finished", "watched_at",
+			}),
+		},
+		clause.Returning{Columns: []clause.Column{{Name: "id"}}},
+	).Create(entry).Error
+}
+
+// DeleteHistoryEntry implements HistoryRepo.
+func (h *historyRepoImpl) DeleteHistoryEntry(ctx context.Context, userID uuid.UUID, provider, episodeSlug string) error {
+	result := h.DB.WithContext(ctx).
+		Where("user_id = ? AND provider = ? AND episode_slug = ?", userID, provider, episodeSlug).
+		Delete(&model.HistoryEntry{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// DeleteUserHistoryBefore implements HistoryRepo. A zero before deletes the
+// user's whole history. It returns how many entries were deleted.
+func (h *historyRepoImpl) DeleteUserHistoryBefore(ctx context.Context, userID uuid.UUID, provider string, before time.Time) (int64, error) {
+	query := h.DB.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider)
+	if !before.IsZero() {
+		query = query.Where("watched_at < ?", before)
+	}
+
+	result := query.Delete(&model.HistoryEntry{})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteHistoryBefore implements HistoryRepo. It returns how many entries
+// were deleted.
+func (h *historyRepoImpl) DeleteHistoryBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := h.DB.WithContext(ctx).Where("watched_at < ?", before).Delete(&model.HistoryEntry{})
+	return result.RowsAffected, result.Error
+}
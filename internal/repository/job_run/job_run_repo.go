@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/job_run"
+)
+
+type JobRunRepo interface {
+	CreateJobRun(ctx context.Context, run *model.JobRun) error
+	UpdateJobRun(ctx context.Context, run *model.JobRun) error
+	DeleteJobRunsBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/job_run"
+
+	"gorm.io/gorm"
+)
+
+type jobRunRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewJobRunRepoImpl(db *gorm.DB) JobRunRepo {
+	return &jobRunRepoImpl{
+		DB: db,
+	}
+}
+
+// CreateJobRun implements JobRunRepo. run.ID is set on return.
+func (r *jobRunRepoImpl) CreateJobRun(ctx context.Context, run *model.JobRun) error {
+	return r.DB.WithContext(ctx).Create(run).Error
+}
+
+// UpdateJobRun implements JobRunRepo. It records how a run ended.
+func (r *jobRunRepoImpl) UpdateJobRun(ctx context.Context, run *model.JobRun) error {
+	return r.DB.WithContext(ctx).Model(run).Select("status", "error", "finished_at", "duration_ms").Updates(run).Error
+}
+
+// DeleteJobRunsBefore implements JobRunRepo. It returns how many runs were
+// deleted.
+func (r *jobRunRepoImpl) DeleteJobRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.DB.WithContext(ctx).Where("started_at < ?", before).Delete(&model.JobRun{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/release"
+)
+
+type ReleaseRepo interface {
+	GetReleaseEvents(ctx context.Context, provider string, param *request.QueryReleaseFeed) ([]model.ReleaseEvent, error)
+	GetReleaseStates(ctx context.Context, provider string) ([]model.ReleaseState, error)
+	RecordReleases(ctx context.Context, states []model.ReleaseState, events []model.ReleaseEvent) error
+}
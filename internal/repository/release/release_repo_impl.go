@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/release"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// recordReleasesLock is the advisory lock key RecordReleases serialises on.
+const recordReleasesLock = 20261017
+
+type releaseRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewReleaseRepoImpl(db *gorm.DB) ReleaseRepo {
+	return &releaseRepoImpl{
+		DB: db,
+	}
+}
+
+// GetReleaseEvents implements ReleaseRepo. Without param.After or
+// param.Since the newest events are returned first; with either, the events
+// after it are returned oldest first so the last one's seq pages forward.
+// param.Limit must already be defaulted.
+func (r *releaseRepoImpl) GetReleaseEvents(ctx context.Context, provider string, param *request.QueryReleaseFeed) ([]model.ReleaseEvent, error) {
+	var events []model.ReleaseEvent
+
+	query := r.DB.WithContext(ctx).Where("provider = ?", provider)
+	switch {
+	case param.After > 0:
+		query = query.Where("seq > ?", param.After).Order("seq asc")
+	case !param.Since.IsZero():
+		query = query.Where("detected_at > ?", param.Since).Order("seq asc")
+	default:
+		query = query.Order("seq desc")
+	}
+
+	err := query.Limit(param.Limit).Find(&events).Error
+	return events, err
+}
+
+// GetReleaseStates implements ReleaseRepo.
+func (r *releaseRepoImpl) GetReleaseStates(ctx context.Context, provider string) ([]model.ReleaseState, error) {
+	var states []model.ReleaseState
+
+	err := r.DB.WithContext(ctx).Where("provider = ?", provider).Find(&states).Error
+	return states, err
+}
+
+// RecordReleases implements ReleaseRepo. States only ever move forward and
+// events already recorded are skipped, so concurrent diffs of overlapping
+// listings record each episode once. Recordings are serialised by an advisory
+// lock held until commit, so no event becomes visible before one with a lower
+// seq and readers paging by seq never skip any.
+func (r *releaseRepoImpl) RecordReleases(ctx context.Context, states []model.ReleaseState, events []model.ReleaseEvent) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", recordReleasesLock).Error; err != nil {
+			return err
+		}
+
+		if len(states) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "provider"}, {Name: "anime_slug"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"latest_ep":  gorm.Expr("GREATEST(release_states.latest_ep, excluded.latest_ep)"),
+					"updated_at": gorm.Expr("excluded.updated_at"),
+				}),
+			}).Create(&states).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&events).Error
+	})
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+)
+
+type WatchlistRepo interface {
+	GetWatchlist(ctx context.Context, userID uuid.UUID, param *request.QueryWatchlist) ([]model.WatchlistItem, int64, error)
+	GetWatchlistItem(ctx context.Context, userID uuid.UUID, provider, slug string) (*model.WatchlistItem, error)
+	CreateWatchlistItem(ctx context.Context, item *model.WatchlistItem) error
+	UpdateWatchlistItem(ctx context.Context, item *model.WatchlistItem) error
+	DeleteWatchlistItem(ctx context.Context, userID uuid.UUID, provider, slug string) error
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+	"gorm.io/gorm"
+)
+
+type watchlistRepoImpl struct {
+	DB *gorm.DB
+}
+
+func NewWatchlistRepoImpl(db *gorm.DB) WatchlistRepo {
+	return &watchlistRepoImpl{
+		DB: db,
+	}
+}
+
+// GetWatchlist implements WatchlistRepo. Recently changed items come first.
+func (w *watchlistRepoImpl) GetWatchlist(ctx context.Context, userID uuid.UUID, param *request.QueryWatchlist) ([]model.WatchlistItem, int64, error) {
+	var items []model.WatchlistItem
+	var total int64
+
+	query := w.DB.WithContext(ctx).Model(&model.WatchlistItem{}).Where("user_id = ?", userID)
+	if param.Status != "" {
+		query = query.Where("status = ?", param.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (param.Page - 1) * param.Limit
+	if err := query.Order("updated_at desc").Order("id").Limit(param.Limit).Offset(offset).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// GetWatchlistItem implements WatchlistRepo.
+func (w *watchlistRepoImpl) GetWatchlistItem(ctx context.Context, userID uuid.UUID, provider, slug string) (*model.WatchlistItem, error) {
+	item := new(model.WatchlistItem)
+
+	result := w.DB.WithContext(ctx).
+		Where("user_id = ? AND provider = ? AND anime_slug = ?", userID, provider, slug).
+		First(item)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return item, nil
+}
+
+// CreateWatchlistItem implements WatchlistRepo.
+func (w *watchlistRepoImpl) CreateWatchlistItem(ctx context.Context, item *model.WatchlistItem) error {
+	return w.DB.WithContext(ctx).Create(item).Error
+}
+
+// UpdateWatchlistItem implements WatchlistRepo. Score and notes are written
+// even when empty, so they can be cleared.
+func (w *watchlistRepoImpl) UpdateWatchlistItem(ctx context.Context, item *model.WatchlistItem) error {
+	result := w.DB.WithContext(ctx).Model(item).
+		Select("status", "score", "notes", "updated_at").
+		Updates(item)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// DeleteWatchlistItem implements WatchlistRepo.
+func (w *watchlistRepoImpl) DeleteWatchlistItem(ctx context.Context, userID uuid.UUID, provider, slug string) error {
+	result := w.DB.WithContext(ctx).
+		Where("user_id = ? AND provider = ? AND anime_slug = ?", userID, provider, slug).
+		Delete(&model.WatchlistItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
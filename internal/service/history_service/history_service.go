@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+)
+
+type HistoryService interface {
+	GetHistory(c *fiber.Ctx, userID uuid.UUID, params *request.QueryHistory) ([]model.HistoryEntry, int64, error)
+	GetContinueWatching(c *fiber.Ctx, userID uuid.UUID, params *request.QueryHistory) ([]model.HistoryEntry, int64, error)
+	ReportProgress(c *fiber.Ctx, userID uuid.UUID, req *request.ReportProgress) (*model.HistoryEntry, error)
+	DeleteHistoryEntry(c *fiber.Ctx, userID uuid.UUID, episodeSlug string) error
+	PruneUserHistory(c *fiber.Ctx, userID uuid.UUID, req *request.PruneHistory) (int64, error)
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
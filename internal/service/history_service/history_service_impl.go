@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/history"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// finishedPercent is how much of an episode has to be watched for it to
+// count as finished, leaving the ending credits out.
+const finishedPercent = 90
+
+// lookUpRetry is how long an episode whose next episode is unknown goes
+// before it is looked up again.
+const lookUpRetry = time.Hour
+
+type historyService struct {
+	Log         *logrus.Logger
+	Validate    *validator.Validate
+	Provider    string
+	Anime       od_service.AnimeService
+	HistoryRepo repository.HistoryRepo
+}
+
+// NewHistoryService keeps watch history of episodes from provider, looking
+// reported episodes up on anime to learn their anime and next episode.
+func NewHistoryService(provider string, anime od_service.AnimeService, historyRepo repository.HistoryRepo, validate *validator.Validate) HistoryService {
+	return &historyService{
+		Log:         utils.Log,
+		Validate:    validate,
+		Provider:    provider,
+		Anime:       anime,
+		HistoryRepo: historyRepo,
+	}
+}
+
+func (s *historyService) GetHistory(c *fiber.Ctx, userID uuid.UUID, params *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	if err := s.query(params); err != nil {
+		return nil, 0, err
+	}
+
+	entries, total, err := s.HistoryRepo.GetHistory(c.Context(), userID, s.Provider, params)
+	if err != nil {
+		s.Log.Errorf("GetHistory failed: %+v", err)
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, "Get history failed")
+	}
+
+	return entries, total, nil
+}
+
+func (s *historyService) GetContinueWatching(c *fiber.Ctx, userID uuid.UUID, params *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	if err := s.query(params); err != nil {
+		return nil, 0, err
+	}
+
+	entries, total, err := s.HistoryRepo.GetContinueWatching(c.Context(), userID, s.Provider, params)
+	if err != nil {
+		s.Log.Errorf("GetContinueWatching failed: %+v", err)
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, "Get continue watching failed")
+	}
+
+	return entries, total, nil
+}
+
+func (s *historyService) query(params *request.QueryHistory) error {
+	if err := s.Validate.Struct(params); err != nil {
+		return err
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 20
+	}
+	return nil
+}
+
+// ReportProgress records how far the user watched an episode. The episode is
+// looked up again at most every lookUpRetry while its next episode is
+// unknown, so an episode reported before the next one aired picks it up on a
+// later report.
+func (s *historyService) ReportProgress(c *fiber.Ctx, userID uuid.UUID, req *request.ReportProgress) (*model.HistoryEntry, error) {
+	if err := s.Validate.Struct(req); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.HistoryRepo.GetHistoryEntry(c.Context(), userID, s.Provider, req.EpisodeSlug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		entry = &model.HistoryEntry{UserID: userID, Provider: s.Provider, EpisodeSlug: req.EpisodeSlug}
+	} else if err != nil {
+		s.Log.Errorf("GetHistoryEntry failed: %+v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Report progress failed")
+	}
+
+	if entry.AnimeSlug == "" || (entry.NextEpisodeSlug == "" && time.Since(entry.LookedUpAt) >= lookUpRetry) {
+		if err := s.lookUp(c, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.DurationSeconds = req.DurationSeconds
+	entry.PositionSeconds = req.PositionSeconds
+	if entry.DurationSeconds > 0 {
+		entry.PositionSeconds = min(entry.PositionSeconds, entry.DurationSeconds)
+	}
+	entry.Finished = entry.DurationSeconds > 0 && entry.PositionSeconds*100 >= entry.DurationSeconds*finishedPercent
+	entry.WatchedAt = time.Now()
+
+	if err := s.HistoryRepo.SaveHistoryEntry(c.Context(), entry); err != nil {
+		s.Log.Errorf("SaveHistoryEntry failed: %+v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Report progress failed")
+	}
+
+	return entry, nil
+}
+
+// lookUp fills in the anime and next episode of entry's episode. An entry
+// already known keeps what it has when the anime source fails, and isn't
+// looked up again before lookUpRetry either way.
+func (s *historyService) lookUp(c *fiber.Ctx, entry *model.HistoryEntry) error {
+	entry.LookedUpAt = time.Now()
+
+	source, err := s.Anime.GetAnimeSourceVid(c.UserContext(), entry.EpisodeSlug)
+	if err == nil && source.AnimeSlug == "" {
+		err = od_anime_entity.ErrLayoutChanged
+	}
+
+	if err != nil {
+		if entry.AnimeSlug != "" {
+			s.Log.Warnf("Failed to look up episode %s, keeping its history: %+v", entry.EpisodeSlug, err)
+			return nil
+		}
+		if errors.Is(err, od_anime_entity.ErrNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Episode not found")
+		}
+
+		s.Log.Errorf("Failed to look up episode %s: %+v", entry.EpisodeSlug, err)
+		return fiber.NewError(fiber.StatusBadGateway, "Anime source unavailable")
+	}
+
+	entry.AnimeSlug = source.AnimeSlug
+	entry.Title = source.Title
+	entry.Episode = source.CurrentEp
+	entry.NextEpisodeSlug = source.NextEpisodeSlug
+	return nil
+}
+
+func (s *historyService) DeleteHistoryEntry(c *fiber.Ctx, userID uuid.UUID, episodeSlug string) error {
+	err := s.HistoryRepo.DeleteHistoryEntry(c.Context(), userID, s.Provider, episodeSlug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "Episode is not in the history")
+	}
+
+	if err != nil {
+		s.Log.Errorf("DeleteHistoryEntry failed: %+v", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Delete history entry failed")
+	}
+
+	return nil
+}
+
+func (s *historyService) PruneUserHistory(c *fiber.Ctx, userID uuid.UUID, req *request.PruneHistory) (int64, error) {
+	deleted, err := s.HistoryRepo.DeleteUserHistoryBefore(c.Context(), userID, s.Provider, req.Before)
+	if err != nil {
+		s.Log.Errorf("PruneUserHistory failed: %+v", err)
+		return 0, fiber.NewError(fiber.StatusInternalServerError, "Clear history failed")
+	}
+
+	return deleted, nil
+}
+
+// Prune deletes the entries of all users last watched before the given time,
+// returning how many were deleted.
+func (s *historyService) Prune(ctx context.Context, before time.Time) (int64, error) {
+	return s.HistoryRepo.DeleteHistoryBefore(ctx, before)
+}
@@ -1,13 +1,23 @@
 package od_service
 
 import (
+	"context"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
 	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
 )
 
 type AnimeService interface {
-	GetHomePage() ([]od_anime_entity.AnimeData, error)
-	GetAnimeEpisode(judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error)
-	GetAnimeSourceVid(judul_eps string) (od_anime_entity.AnimeSourceData, error)
-	GetAnimeGenreList(genre string, page string) ([]od_anime_entity.GenreAnime, error)
-	GetAnimeByTitle(title string) ([]od_anime_entity.SearchResult, error)
+	GetHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error)
+	GetAnimeEpisode(ctx context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error)
+	GetAnimeSourceVid(ctx context.Context, judul_eps string) (od_anime_entity.AnimeSourceData, error)
+	GetStreamServer(ctx context.Context, judulEps, serverID string) (od_anime_entity.StreamServer, error)
+	GetAnimeBatch(ctx context.Context, batchSlug string) (od_anime_entity.AnimeBatch, error)
+	GetGenres(ctx context.Context) ([]od_anime_entity.GenreInfo, error)
+	GetAnimeGenreList(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error)
+	GetAnimeGenrePage(ctx context.Context, genre string, page int) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error)
+	GetAnimeByTitle(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error)
+	GetOngoingAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error)
+	GetCompletedAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error)
+	GetSchedule(ctx context.Context, query *request.QuerySchedule) ([]od_anime_entity.ScheduleDay, error)
 }
@@ -0,0 +1,314 @@
+package od_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/util/response"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/cache"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheMetaKey is the context key WithCacheMeta stores the cache outcome under.
+type cacheMetaKey struct{}
+
+// CacheTTL holds how long each AnimeService operation stays fresh. A zero
+// duration disables caching for that operation. Once fresh time is over an
+// entry is still served for up to Stale while it is refreshed in the background.
+type CacheTTL struct {
+	Home      time.Duration
+	Detail    time.Duration
+	Episode   time.Duration
+	Batch     time.Duration
+	Genre     time.Duration
+	Genres    time.Duration
+	Search    time.Duration
+	Ongoing   time.Duration
+	Completed time.Duration
+	Schedule  time.Duration
+	Stale     time.Duration
+}
+
+// CachedAnimeService is an AnimeService whose list pages can also be
+// refreshed ahead of requests, e.g. by scheduled jobs. Refresh results are
+// shared with concurrent readers and must not be modified.
+type CachedAnimeService interface {
+	AnimeService
+	RefreshHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error)
+	RefreshOngoingAnime(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error)
+	RefreshSchedule(ctx context.Context) ([]od_anime_entity.ScheduleDay, error)
+}
+
+type cachedAnimeService struct {
+	Log     *logrus.Logger
+	Next    AnimeService
+	Store   cache.Store
+	Prefix  string
+	TTL     CacheTTL
+	Timeout time.Duration
+	Group   *singleflight.Group
+}
+
+// NewCachedAnimeService decorates next with a read-through cache. Keys are
+// namespaced by prefix (the provider name) so providers never share entries.
+// Concurrent misses for the same key share a single upstream fetch, which is
+// bounded by timeout rather than by any one request.
+func NewCachedAnimeService(next AnimeService, store cache.Store, prefix string, ttl CacheTTL, timeout time.Duration) CachedAnimeService {
+	return &cachedAnimeService{
+		Log:     utils.Log,
+		Next:    next,
+		Store:   store,
+		Prefix:  prefix,
+		TTL:     ttl,
+		Timeout: timeout,
+		Group:   new(singleflight.Group),
+	}
+}
+
+// WithCacheMeta returns a copy of ctx in which calls through the cache record
+// their outcome, for CacheMetaOf to report once the call returns.
+func WithCacheMeta(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheMetaKey{}, new(response.CacheMeta))
+}
+
+// CacheMetaOf returns the cache outcome recorded in ctx, or nil when ctx
+// doesn't come from WithCacheMeta or the call was not served through the cache.
+func CacheMetaOf(ctx context.Context) *response.CacheMeta {
+	meta, _ := ctx.Value(cacheMetaKey{}).(*response.CacheMeta)
+	if meta == nil || meta.Status == "" {
+		return nil
+	}
+	return meta
+}
+
+func (s *cachedAnimeService) GetHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+	return cached(s, ctx, "home", s.TTL.Home, func(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+		return s.Next.GetHomePage(ctx)
+	})
+}
+
+func (s *cachedAnimeService) GetAnimeEpisode(ctx context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	judul = strings.Clone(judul)
+	page, err := cached(s, ctx, "detail:"+judul, s.TTL.Detail, func(ctx context.Context) (od_anime_entity.EpisodePageResult, error) {
+		detail, eps, err := s.Next.GetAnimeEpisode(ctx, judul)
+		return od_anime_entity.EpisodePageResult{AnimeDetail: detail, AnimeEps: eps}, err
+	})
+	if err != nil {
+		return od_anime_entity.AnimeDetail{}, nil, err
+	}
+
+	return page.AnimeDetail, page.AnimeEps, nil
+}
+
+func (s *cachedAnimeService) GetAnimeSourceVid(ctx context.Context, judul_eps string) (od_anime_entity.AnimeSourceData, error) {
+	judul_eps = strings.Clone(judul_eps)
+	return cached(s, ctx, "episode:"+judul_eps, s.TTL.Episode, func(ctx context.Context) (od_anime_entity.AnimeSourceData, error) {
+		return s.Next.GetAnimeSourceVid(ctx, judul_eps)
+	})
+}
+
+func (s *cachedAnimeService) GetStreamServer(ctx context.Context, judulEps, serverID string) (od_anime_entity.StreamServer, error) {
+	judulEps, serverID = strings.Clone(judulEps), strings.Clone(serverID)
+	return cached(s, ctx, "server:"+judulEps+":"+serverID, s.TTL.Episode, func(ctx context.Context) (od_anime_entity.StreamServer, error) {
+		return s.Next.GetStreamServer(ctx, judulEps, serverID)
+	})
+}
+
+func (s *cachedAnimeService) GetAnimeBatch(ctx context.Context, batchSlug string) (od_anime_entity.AnimeBatch, error) {
+	batchSlug = strings.Clone(batchSlug)
+	return cached(s, ctx, "batch:"+batchSlug, s.TTL.Batch, func(ctx context.Context) (od_anime_entity.AnimeBatch, error) {
+		return s.Next.GetAnimeBatch(ctx, batchSlug)
+	})
+}
+
+func (s *cachedAnimeService) GetGenres(ctx context.Context) ([]od_anime_entity.GenreInfo, error) {
+	return cached(s, ctx, "genres", s.TTL.Genres, func(ctx context.Context) ([]od_anime_entity.GenreInfo, error) {
+		return s.Next.GetGenres(ctx)
+	})
+}
+
+func (s *cachedAnimeService) GetAnimeGenreList(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	genre = strings.Clone(genre)
+	return cached(s, ctx, "genre:"+genre+":"+pageKey(query), s.TTL.Genre, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+		return s.Next.GetAnimeGenreList(ctx, genre, query)
+	})
+}
+
+func (s *cachedAnimeService) GetAnimeGenrePage(ctx context.Context, genre string, page int) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	genre = strings.Clone(genre)
+	return cached(s, ctx, "genre-page:"+genre+":"+strconv.Itoa(page), s.TTL.Genre, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+		return s.Next.GetAnimeGenrePage(ctx, genre, page)
+	})
+}
+
+func (s *cachedAnimeService) GetAnimeByTitle(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error) {
+	title = strings.Clone(title)
+	return cached(s, ctx, "search:"+title+":"+pageKey(query), s.TTL.Search, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error) {
+		return s.Next.GetAnimeByTitle(ctx, title, query)
+	})
+}
+
+func (s *cachedAnimeService) GetOngoingAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+	return cached(s, ctx, "ongoing:"+pageKey(query), s.TTL.Ongoing, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+		return s.Next.GetOngoingAnime(ctx, query)
+	})
+}
+
+func (s *cachedAnimeService) GetCompletedAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+	return cached(s, ctx, "completed:"+pageKey(query), s.TTL.Completed, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+		return s.Next.GetCompletedAnime(ctx, query)
+	})
+}
+
+func (s *cachedAnimeService) GetSchedule(ctx context.Context, query *request.QuerySchedule) ([]od_anime_entity.ScheduleDay, error) {
+	query = &request.QuerySchedule{Day: strings.Clone(query.Day)}
+	return cached(s, ctx, "schedule:"+query.Day, s.TTL.Schedule, func(ctx context.Context) ([]od_anime_entity.ScheduleDay, error) {
+		return s.Next.GetSchedule(ctx, query)
+	})
+}
+
+// RefreshHomePage refetches the home page into the cache.
+func (s *cachedAnimeService) RefreshHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+	return refresh(s, ctx, "home", s.TTL.Home, func(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+		return s.Next.GetHomePage(ctx)
+	})
+}
+
+// RefreshOngoingAnime refetches the first page of ongoing anime, at the
+// default page size, into the cache.
+func (s *cachedAnimeService) RefreshOngoingAnime(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+	query := &request.QueryAnimeList{}
+	return refresh(s, ctx, "ongoing:"+pageKey(query), s.TTL.Ongoing, func(ctx context.Context) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+		return s.Next.GetOngoingAnime(ctx, query)
+	})
+}
+
+// RefreshSchedule refetches the full week's schedule into the cache.
+func (s *cachedAnimeService) RefreshSchedule(ctx context.Context) ([]od_anime_entity.ScheduleDay, error) {
+	query := &request.QuerySchedule{}
+	return refresh(s, ctx, "schedule:"+query.Day, s.TTL.Schedule, func(ctx context.Context) ([]od_anime_entity.ScheduleDay, error) {
+		return s.Next.GetSchedule(ctx, query)
+	})
+}
+
+// pageKey identifies a page/limit window in cache keys, after defaults are applied.
+func pageKey(query *request.QueryAnimeList) string {
+	page, limit := pageQuery(query)
+	return strconv.Itoa(page) + ":" + strconv.Itoa(limit)
+}
+
+// cached serves key from the store when present, otherwise calls fetch and
+// stores its result. Fresh entries are a HIT; entries past ttl are served as
+// STALE while a background refresh runs. Fetches are shared between callers
+// and may outlive ctx, so fetch receives a context of its own (see detached)
+// and the strings it captures must not point into request memory, which
+// Fiber reuses: methods clone their params.
+func cached[T any](s *cachedAnimeService, ctx context.Context, key string, ttl time.Duration, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if ttl <= 0 {
+		return fetch(ctx)
+	}
+
+	key = s.Prefix + ":" + key
+
+	entry, err := s.Store.Get(ctx, key)
+	if err == nil {
+		var value T
+		if err := json.Unmarshal(entry.Value, &value); err == nil {
+			age := time.Since(entry.StoredAt)
+			if age < ttl {
+				setCacheMeta(ctx, "HIT", age)
+				return value, nil
+			}
+
+			setCacheMeta(ctx, "STALE", age)
+			go func() {
+				if _, err := load(s, context.Background(), key, ttl, fetch); err != nil {
+					s.Log.Errorf("Failed to revalidate cache entry %s: %+v", key, err)
+				}
+			}()
+			return value, nil
+		}
+		s.Log.Errorf("Failed to decode cache entry %s: %+v", key, err)
+	} else if !errors.Is(err, cache.ErrMiss) {
+		s.Log.Errorf("Failed to read cache entry %s: %+v", key, err)
+	}
+
+	setCacheMeta(ctx, "MISS", 0)
+	return load(s, ctx, key, ttl, fetch)
+}
+
+// refresh fetches key upstream regardless of what is cached, storing the
+// result under the same key cached uses. With caching disabled it only
+// fetches.
+func refresh[T any](s *cachedAnimeService, ctx context.Context, key string, ttl time.Duration, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if ttl <= 0 {
+		return detached(s, ctx, fetch)
+	}
+
+	return load(s, ctx, s.Prefix+":"+key, ttl, fetch)
+}
+
+// load fetches key upstream and stores the result, coalescing concurrent
+// callers for the same key into one fetch. The shared fetch runs under its
+// own deadline; ctx only limits how long this caller waits for it.
+func load[T any](s *cachedAnimeService, ctx context.Context, key string, ttl time.Duration, fetch func(ctx context.Context) (T, error)) (T, error) {
+	ch := s.Group.DoChan(key, func() (interface{}, error) {
+		value, err := detached(s, context.Background(), fetch)
+		if err != nil {
+			return value, err
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			s.Log.Errorf("Failed to encode cache entry %s: %+v", key, err)
+			return value, nil
+		}
+
+		now := time.Now()
+		entry := &cache.Entry{Value: raw, StoredAt: now, ExpiresAt: now.Add(ttl + s.TTL.Stale)}
+		if err := s.Store.Set(context.Background(), key, entry); err != nil {
+			s.Log.Errorf("Failed to write cache entry %s: %+v", key, err)
+		}
+
+		return value, nil
+	})
+
+	select {
+	case res := <-ch:
+		value, _ := res.Val.(T)
+		return value, res.Err
+	case <-ctx.Done():
+		var zero T
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return zero, od_anime_entity.ErrTimeout
+		}
+		return zero, ctx.Err()
+	}
+}
+
+// detached calls fetch with a context derived from parent and bounded by
+// s.Timeout, so an abandoned fetch is still cancelled once the upstream is
+// too slow.
+func detached[T any](s *cachedAnimeService, parent context.Context, fetch func(ctx context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(parent, s.Timeout)
+	defer cancel()
+
+	return fetch(ctx)
+}
+
+// setCacheMeta records the cache outcome in ctx when it comes from WithCacheMeta.
+func setCacheMeta(ctx context.Context, status string, age time.Duration) {
+	if meta, ok := ctx.Value(cacheMetaKey{}).(*response.CacheMeta); ok {
+		meta.Status = status
+		meta.AgeSeconds = int64(age / time.Second)
+	}
+}
@@ -0,0 +1,390 @@
+package od_service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/anime"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var episodeNumberPattern = regexp.MustCompile(`(?i)\bepisode\s+(\d+)`)
+
+// staleSyncShare reserves 1/staleSyncShare of each sync batch for stale
+// titles, so they are still rescraped while the index is being backfilled.
+const staleSyncShare = 4
+
+// CatalogSync configures the catalogue sync. Each sync scrapes up to Batch
+// titles, Concurrency at a time: titles of the A–Z index not catalogued yet
+// and titles that aren't completed and were last synced over StaleAfter ago.
+// Titles that aren't completed are only read from the catalogue for MaxAge
+// after their sync, so their episode lists are as fresh as scraped ones.
+type CatalogSync struct {
+	StaleAfter  time.Duration
+	MaxAge      time.Duration
+	Batch       int
+	Concurrency int
+	Timeout     time.Duration
+}
+
+// AnimeCatalogService serves anime from the catalogue tables, which Sync fills
+// from the provider's detail pages so reads never scrape. Reads report
+// ErrNotFound for what the catalogue can't answer yet.
+type AnimeCatalogService interface {
+	GetCatalogAnime(ctx context.Context, slug string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error)
+	GetCatalogGenre(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error)
+	SearchCatalog(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error)
+	Sync(ctx context.Context) (int, error)
+}
+
+type animeCatalogService struct {
+	Log      *logrus.Logger
+	Validate *validator.Validate
+	Provider od_anime_entity.Provider
+	Repo     repository.AnimeRepo
+	Options  CatalogSync
+	Links    linker
+}
+
+func NewAnimeCatalogService(provider od_anime_entity.Provider, repo repository.AnimeRepo, validate *validator.Validate, opts CatalogSync) AnimeCatalogService {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	return &animeCatalogService{
+		Log:      utils.Log,
+		Validate: validate,
+		Provider: provider,
+		Repo:     repo,
+		Options:  opts,
+		Links:    newLinker(provider.Name()),
+	}
+}
+
+// GetCatalogGenre lists the catalogued anime of genre by title.
+func (s *animeCatalogService) GetCatalogGenre(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	result, err := listCatalog(s, ctx, query, &request.QueryAnimeCatalog{Genre: genre}, catalogGenreAnime)
+	if err == nil {
+		s.Links.genreAnime(result.Items)
+	}
+	return result, err
+}
+
+// SearchCatalog lists the catalogued anime whose title contains title.
+func (s *animeCatalogService) SearchCatalog(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error) {
+	result, err := listCatalog(s, ctx, query, &request.QueryAnimeCatalog{Search: title}, catalogSearchResult)
+	if err == nil {
+		s.Links.searchResults(result.Items)
+	}
+	return result, err
+}
+
+// listCatalog pages through the catalogue rows matching filter. Until every
+// title of the A–Z index is catalogued a list could miss anime, and upstream
+// may match titles the catalogue doesn't, so both an incomplete catalogue and
+// an empty result report ErrNotFound.
+func listCatalog[T any](s *animeCatalogService, ctx context.Context, query *request.QueryAnimeList, filter *request.QueryAnimeCatalog, convert func(model.Anime) T) (od_anime_entity.Paginated[T], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[T]{}, err
+	}
+
+	provider := s.Provider.Name()
+	unsynced, err := s.Repo.UnsyncedIndexSlugs(ctx, provider, 1)
+	if err != nil {
+		s.Log.Errorf("listCatalog failed: %+v", err)
+		return od_anime_entity.Paginated[T]{}, err
+	}
+	if len(unsynced) > 0 {
+		return od_anime_entity.Paginated[T]{}, od_anime_entity.ErrNotFound
+	}
+
+	filter.Page, filter.Limit = pageQuery(query)
+	rows, total, err := s.Repo.GetAllAnime(ctx, provider, filter)
+	if err != nil {
+		s.Log.Errorf("listCatalog failed: %+v", err)
+		return od_anime_entity.Paginated[T]{}, err
+	}
+	if total == 0 {
+		return od_anime_entity.Paginated[T]{}, od_anime_entity.ErrNotFound
+	}
+
+	items := make([]T, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, convert(row))
+	}
+
+	return od_anime_entity.Paginated[T]{
+		Items:        items,
+		Page:         filter.Page,
+		Limit:        filter.Limit,
+		TotalPages:   (total + int64(filter.Limit) - 1) / int64(filter.Limit),
+		TotalResults: total,
+	}, nil
+}
+
+func (s *animeCatalogService) GetCatalogAnime(ctx context.Context, slug string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	row, err := s.Repo.GetAnimeBySlug(ctx, s.Provider.Name(), slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return od_anime_entity.AnimeDetail{}, nil, od_anime_entity.ErrNotFound
+	}
+	if err != nil {
+		s.Log.Errorf("GetCatalogAnime failed: %+v", err)
+		return od_anime_entity.AnimeDetail{}, nil, err
+	}
+
+	if row.Status != string(od_anime_entity.StatusCompleted) && time.Since(row.SyncedAt) > s.Options.MaxAge {
+		return od_anime_entity.AnimeDetail{}, nil, od_anime_entity.ErrNotFound
+	}
+
+	detail, eps := catalogDetail(*row)
+	s.Links.animeDetail(&detail, eps)
+	return detail, eps, nil
+}
+
+// Sync scrapes and stores one batch of titles, returning how many were
+// stored. Titles that fail are logged and left for the next sync; Sync only
+// fails when the batch can't be chosen or every title in it failed.
+func (s *animeCatalogService) Sync(ctx context.Context) (int, error) {
+	slugs, err := s.syncCandidates(ctx)
+	if err != nil || len(slugs) == 0 {
+		return 0, err
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		synced  int
+		lastErr error
+	)
+	sem := make(chan struct{}, s.Options.Concurrency)
+
+	for _, slug := range slugs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(slug string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := s.syncAnime(ctx, slug)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				s.Log.Warnf("Failed to sync anime %s: %+v", slug, err)
+				lastErr = err
+				return
+			}
+			synced++
+		}(slug)
+	}
+	wg.Wait()
+
+	if synced == 0 && lastErr != nil {
+		return 0, lastErr
+	}
+	return synced, nil
+}
+
+// syncCandidates picks the batch: unsynced titles first, except for the share
+// reserved for stale titles, which also take whatever room is left.
+func (s *animeCatalogService) syncCandidates(ctx context.Context) ([]string, error) {
+	provider := s.Provider.Name()
+	batch := s.Options.Batch
+	if batch < 1 {
+		return nil, nil
+	}
+
+	stale, err := s.Repo.StaleAnimeSlugs(ctx, provider, time.Now().Add(-s.Options.StaleAfter), batch)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := min(len(stale), max(batch/staleSyncShare, 1))
+	slugs, err := s.Repo.UnsyncedIndexSlugs(ctx, provider, batch-reserved)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(slugs, stale[:min(len(stale), batch-len(slugs))]...), nil
+}
+
+func (s *animeCatalogService) syncAnime(ctx context.Context, slug string) error {
+	scrapeCtx, cancel := context.WithTimeout(ctx, s.Options.Timeout)
+	defer cancel()
+
+	detail, eps, err := s.Provider.ScrapeAnimeDetail(scrapeCtx, slug)
+	if err != nil {
+		return err
+	}
+	if detail.AnimeSlug == "" {
+		detail.AnimeSlug = slug
+	}
+
+	return s.Repo.UpsertAnime(ctx, catalogAnime(s.Provider.Name(), detail, eps))
+}
+
+// catalogAnime converts a scraped anime into its catalogue row. Duplicate
+// genres and studios are dropped, since each is linked once.
+func catalogAnime(provider string, detail od_anime_entity.AnimeDetail, eps []od_anime_entity.AnimeEpisode) *model.Anime {
+	anime := &model.Anime{
+		Provider:        provider,
+		Slug:            detail.AnimeSlug,
+		Title:           detail.Title,
+		ThumbnailURL:    detail.ThumbnailURL,
+		Synopsis:        detail.Synopsis,
+		Rating:          detail.Rating,
+		Producer:        detail.Producer,
+		Status:          string(detail.Status),
+		TotalEps:        detail.TotalEps,
+		DurationMinutes: detail.DurationMinutes,
+		BatchSlug:       detail.BatchSlug,
+		SyncedAt:        time.Now(),
+	}
+
+	if detail.ReleaseDate != nil {
+		if date, err := time.Parse(time.DateOnly, *detail.ReleaseDate); err == nil {
+			anime.ReleaseDate = &date
+		}
+	}
+
+	seenGenres := make(map[string]bool, len(detail.Genres))
+	for _, genre := range detail.Genres {
+		if genre.Slug == "" || seenGenres[genre.Slug] {
+			continue
+		}
+		seenGenres[genre.Slug] = true
+		anime.Genres = append(anime.Genres, model.Genre{Slug: genre.Slug, Title: genre.Title})
+	}
+
+	seenStudios := make(map[string]bool)
+	for _, name := range strings.Split(detail.Studio, ",") {
+		if name = strings.TrimSpace(name); name == "" || seenStudios[name] {
+			continue
+		}
+		seenStudios[name] = true
+		anime.Studios = append(anime.Studios, model.Studio{Name: name})
+	}
+
+	seenEpisodes := make(map[string]bool, len(eps))
+	for _, ep := range eps {
+		if ep.EpisodeSlug == "" || seenEpisodes[ep.EpisodeSlug] {
+			continue
+		}
+		seenEpisodes[ep.EpisodeSlug] = true
+		anime.Episodes = append(anime.Episodes, model.Episode{
+			Slug:     ep.EpisodeSlug,
+			Title:    ep.Title,
+			URL:      ep.VideoURL,
+			Number:   episodeNumber(ep.Title),
+			Position: len(anime.Episodes),
+		})
+	}
+
+	return anime
+}
+
+// catalogDetail converts a catalogue row back into the shape the scraping
+// endpoints return. Raw upstream text isn't stored, so Raw stays empty.
+func catalogDetail(anime model.Anime) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode) {
+	detail := od_anime_entity.AnimeDetail{
+		AnimeSlug:       anime.Slug,
+		ThumbnailURL:    anime.ThumbnailURL,
+		Title:           anime.Title,
+		Rating:          anime.Rating,
+		Producer:        anime.Producer,
+		Status:          od_anime_entity.AnimeStatus(anime.Status),
+		TotalEps:        anime.TotalEps,
+		DurationMinutes: anime.DurationMinutes,
+		Genres:          make([]od_anime_entity.GenreInfo, 0, len(anime.Genres)),
+		Synopsis:        anime.Synopsis,
+		BatchSlug:       anime.BatchSlug,
+	}
+
+	if anime.ReleaseDate != nil {
+		date := anime.ReleaseDate.Format(time.DateOnly)
+		detail.ReleaseDate = &date
+	}
+
+	for _, genre := range anime.Genres {
+		detail.Genres = append(detail.Genres, od_anime_entity.GenreInfo{Title: genre.Title, Slug: genre.Slug})
+	}
+
+	studios := make([]string, 0, len(anime.Studios))
+	for _, studio := range anime.Studios {
+		studios = append(studios, studio.Name)
+	}
+	detail.Studio = strings.Join(studios, ", ")
+
+	eps := make([]od_anime_entity.AnimeEpisode, 0, len(anime.Episodes))
+	for _, ep := range anime.Episodes {
+		eps = append(eps, od_anime_entity.AnimeEpisode{
+			Title:       ep.Title,
+			VideoURL:    ep.URL,
+			EpisodeSlug: ep.Slug,
+		})
+	}
+
+	return detail, eps
+}
+
+// catalogGenreAnime and catalogSearchResult convert a catalogue row into the
+// list items the scraping endpoints return, with Raw left empty.
+func catalogGenreAnime(anime model.Anime) od_anime_entity.GenreAnime {
+	detail, _ := catalogDetail(anime)
+
+	return od_anime_entity.GenreAnime{
+		Title:     detail.Title,
+		URL:       anime.URL,
+		AnimeSlug: detail.AnimeSlug,
+		Studio:    detail.Studio,
+		Episodes:  detail.TotalEps,
+		Rating:    detail.Rating,
+	}
+}
+
+func catalogSearchResult(anime model.Anime) od_anime_entity.SearchResult {
+	detail, _ := catalogDetail(anime)
+
+	return od_anime_entity.SearchResult{
+		Title:        detail.Title,
+		URL:          anime.URL,
+		AnimeSlug:    detail.AnimeSlug,
+		ThumbnailURL: detail.ThumbnailURL,
+		Genres:       detail.Genres,
+		Status:       detail.Status,
+		Rating:       detail.Rating,
+	}
+}
+
+// episodeNumber reads the number out of titles like "Dr. Stone Episode 12
+// Subtitle Indonesia", or nil when the title has none.
+func episodeNumber(title string) *int {
+	match := episodeNumberPattern.FindStringSubmatch(title)
+	if match == nil {
+		return nil
+	}
+
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return nil
+	}
+	return &number
+}
@@ -0,0 +1,74 @@
+package od_service
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+type cataloguedAnimeService struct {
+	AnimeService
+	Log     *logrus.Logger
+	Catalog AnimeCatalogService
+}
+
+// NewCataloguedAnimeService serves anime details, genre lists and searches
+// from catalog, falling back to scraping through next for whatever the
+// catalogue can't answer yet.
+func NewCataloguedAnimeService(next AnimeService, catalog AnimeCatalogService) AnimeService {
+	return &cataloguedAnimeService{
+		AnimeService: next,
+		Log:          utils.Log,
+		Catalog:      catalog,
+	}
+}
+
+func (s *cataloguedAnimeService) GetAnimeEpisode(ctx context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	detail, eps, err := s.Catalog.GetCatalogAnime(ctx, judul)
+	if err == nil {
+		return detail, eps, nil
+	}
+
+	s.fallback("GetAnimeEpisode", err)
+	return s.AnimeService.GetAnimeEpisode(ctx, judul)
+}
+
+func (s *cataloguedAnimeService) GetAnimeGenreList(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	result, err := s.Catalog.GetCatalogGenre(ctx, genre, query)
+	if err == nil || isValidation(err) {
+		return result, err
+	}
+
+	s.fallback("GetAnimeGenreList", err)
+	return s.AnimeService.GetAnimeGenreList(ctx, genre, query)
+}
+
+func (s *cataloguedAnimeService) GetAnimeByTitle(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error) {
+	result, err := s.Catalog.SearchCatalog(ctx, title, query)
+	if err == nil || isValidation(err) {
+		return result, err
+	}
+
+	s.fallback("GetAnimeByTitle", err)
+	return s.AnimeService.GetAnimeByTitle(ctx, title, query)
+}
+
+// fallback logs catalogue failures other than ErrNotFound, which only means
+// the catalogue doesn't hold the answer yet.
+func (s *cataloguedAnimeService) fallback(op string, err error) {
+	if !errors.Is(err, od_anime_entity.ErrNotFound) {
+		s.Log.Warnf("%s: catalogue read failed, scraping instead: %+v", op, err)
+	}
+}
+
+func isValidation(err error) bool {
+	var validationErr validator.ValidationErrors
+	return errors.As(err, &validationErr)
+}
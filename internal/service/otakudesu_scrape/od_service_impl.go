@@ -1,44 +1,268 @@
 package od_service
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
 	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
-	modules "github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/modules/scrape_otakudesu"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
 )
 
-type animeService struct{}
+type animeService struct {
+	Log      *logrus.Logger
+	Validate *validator.Validate
+	Provider od_anime_entity.Provider
+	Timeout  time.Duration
+	Links    linker
+}
 
-func NewAnimeService() AnimeService {
-	return &animeService{}
+func NewAnimeService(provider od_anime_entity.Provider, validate *validator.Validate, timeout time.Duration) AnimeService {
+	return &animeService{
+		Log:      utils.Log,
+		Validate: validate,
+		Provider: provider,
+		Timeout:  timeout,
+		Links:    newLinker(provider.Name()),
+	}
 }
 
-var mainUrl = "https://otakudesu.cloud"
+func (s *animeService) GetHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
 
-func (s *animeService) GetHomePage() ([]od_anime_entity.AnimeData, error) {
-	animes := modules.ScrapeHomePage()
+	animes, err := s.Provider.ScrapeHomePage(ctx)
+	if err != nil {
+		s.Log.Errorf("GetHomePage failed: %+v", err)
+		return nil, err
+	}
 
+	s.Links.animeData(animes)
 	return animes, nil
 }
 
-func (s *animeService) GetAnimeEpisode(judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
-	detail, eps := modules.ScrapeAnimeEpisodes(mainUrl + ("/anime/" + judul))
+func (s *animeService) GetAnimeEpisode(ctx context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
 
+	detail, eps, err := s.Provider.ScrapeAnimeDetail(ctx, judul)
+	if err != nil {
+		s.Log.Errorf("GetAnimeEpisode failed: %+v", err)
+		return od_anime_entity.AnimeDetail{}, nil, err
+	}
+
+	s.Links.animeDetail(&detail, eps)
 	return detail, eps, nil
 }
 
-func (s *animeService) GetAnimeSourceVid(judul_eps string) (od_anime_entity.AnimeSourceData, error) {
-	animSource := modules.ScrapeAnimeSourceData(mainUrl + ("/episode/" + judul_eps))
+func (s *animeService) GetAnimeSourceVid(ctx context.Context, judul_eps string) (od_anime_entity.AnimeSourceData, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	animSource, err := s.Provider.ScrapeAnimeSourceData(ctx, judul_eps)
+	if err != nil {
+		s.Log.Errorf("GetAnimeSourceVid failed: %+v", err)
+		return od_anime_entity.AnimeSourceData{}, err
+	}
 
+	s.Links.sourceData(&animSource)
 	return animSource, nil
 }
 
-func (s *animeService) GetAnimeGenreList(genre string, page string) ([]od_anime_entity.GenreAnime, error) {
-	animGenre := modules.ScrapeGenreAnime(mainUrl + "/genres/" + (genre + "/page/" + page))
+func (s *animeService) GetStreamServer(ctx context.Context, judulEps, serverID string) (od_anime_entity.StreamServer, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	server, err := s.Provider.ResolveStreamServer(ctx, judulEps, serverID)
+	if err != nil {
+		s.Log.Errorf("GetStreamServer failed: %+v", err)
+		return od_anime_entity.StreamServer{}, err
+	}
+
+	s.Links.streamServer(judulEps, &server)
+	return server, nil
+}
+
+func (s *animeService) GetAnimeBatch(ctx context.Context, batchSlug string) (od_anime_entity.AnimeBatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	batch, err := s.Provider.ScrapeAnimeBatch(ctx, batchSlug)
+	if err != nil {
+		s.Log.Errorf("GetAnimeBatch failed: %+v", err)
+		return od_anime_entity.AnimeBatch{}, err
+	}
+
+	s.Links.batch(&batch)
+	return batch, nil
+}
+
+func (s *animeService) GetGenres(ctx context.Context) ([]od_anime_entity.GenreInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	genres, err := s.Provider.ScrapeGenres(ctx)
+	if err != nil {
+		s.Log.Errorf("GetGenres failed: %+v", err)
+		return nil, err
+	}
+
+	s.Links.genres(genres)
+	return genres, nil
+}
+
+// GetAnimeGenreList reports ErrGenreNotFound when upstream has no page for
+// genre or lists no anime under it, which is how unknown genres show up there.
+func (s *animeService) GetAnimeGenreList(ctx context.Context, genre string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	page, limit := pageQuery(query)
+	animGenre, err := paginate(ctx, page, limit, func(ctx context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.GenreAnime], error) {
+		return s.Provider.ScrapeGenreAnime(ctx, genre, page)
+	})
+	if errors.Is(err, od_anime_entity.ErrNotFound) || (err == nil && animGenre.TotalResults == 0) {
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, od_anime_entity.ErrGenreNotFound
+	}
+	if err != nil {
+		s.Log.Errorf("GetAnimeGenreList failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, err
+	}
+
+	s.Links.genreAnime(animGenre.Items)
+	return animGenre, nil
+}
+
+// GetAnimeGenrePage returns upstream page `page` of genre as is, for the
+// legacy /genre/:genre/page/:page route. Pages past the end of a known genre
+// are empty rather than ErrGenreNotFound.
+func (s *animeService) GetAnimeGenrePage(ctx context.Context, genre string, page int) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	if err := s.Validate.Var(page, "min=1"); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, err
+	}
 
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	first, err := s.Provider.ScrapeGenreAnime(ctx, genre, 1)
+	if errors.Is(err, od_anime_entity.ErrNotFound) || (err == nil && len(first.Items) == 0) {
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, od_anime_entity.ErrGenreNotFound
+	}
+	if err != nil {
+		s.Log.Errorf("GetAnimeGenrePage failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, err
+	}
+
+	animGenre, err := paginate(ctx, page, len(first.Items), func(ctx context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.GenreAnime], error) {
+		if page == 1 {
+			return first, nil
+		}
+		return s.Provider.ScrapeGenreAnime(ctx, genre, page)
+	})
+	if errors.Is(err, od_anime_entity.ErrNotFound) {
+		// Upstream may list fewer pages than it advertises.
+		animGenre.Items, err = []od_anime_entity.GenreAnime{}, nil
+	}
+	if err != nil {
+		s.Log.Errorf("GetAnimeGenrePage failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, err
+	}
+
+	s.Links.genreAnime(animGenre.Items)
 	return animGenre, nil
 }
 
-func (s *animeService) GetAnimeByTitle(title string) ([]od_anime_entity.SearchResult, error) {
-	animSearch := modules.ScrapeSearchAnimeByTitle(mainUrl + "?s=" + title + "&post_type=anime")
+func (s *animeService) GetAnimeByTitle(ctx context.Context, title string, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.SearchResult], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.SearchResult]{}, err
+	}
 
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	page, limit := pageQuery(query)
+	animSearch, err := paginate(ctx, page, limit, func(ctx context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.SearchResult], error) {
+		return s.Provider.ScrapeSearchAnime(ctx, title, page)
+	})
+	if err != nil {
+		s.Log.Errorf("GetAnimeByTitle failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.SearchResult]{}, err
+	}
+
+	s.Links.searchResults(animSearch.Items)
 	return animSearch, nil
 }
+
+func (s *animeService) GetOngoingAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.AnimeData]{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	page, limit := pageQuery(query)
+	animes, err := paginate(ctx, page, limit, s.Provider.ScrapeOngoingAnime)
+	if err != nil {
+		s.Log.Errorf("GetOngoingAnime failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.AnimeData]{}, err
+	}
+
+	s.Links.animeData(animes.Items)
+	return animes, nil
+}
+
+func (s *animeService) GetCompletedAnime(ctx context.Context, query *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.AnimeData], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.AnimeData]{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	page, limit := pageQuery(query)
+	animes, err := paginate(ctx, page, limit, s.Provider.ScrapeCompletedAnime)
+	if err != nil {
+		s.Log.Errorf("GetCompletedAnime failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.AnimeData]{}, err
+	}
+
+	s.Links.animeData(animes.Items)
+	return animes, nil
+}
+
+// GetSchedule returns the weekly release schedule, or only query.Day's group
+// when a day is given.
+func (s *animeService) GetSchedule(ctx context.Context, query *request.QuerySchedule) ([]od_anime_entity.ScheduleDay, error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	schedule, err := s.Provider.ScrapeSchedule(ctx)
+	if err != nil {
+		s.Log.Errorf("GetSchedule failed: %+v", err)
+		return nil, err
+	}
+
+	result := []od_anime_entity.ScheduleDay{}
+	for _, day := range schedule {
+		if query.Day != "" && day.Day != query.Day {
+			continue
+		}
+		s.Links.scheduleAnime(day.Anime)
+		result = append(result, day)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,125 @@
+package od_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime_index"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/anime_index"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// AnimeIndexService serves a provider's A–Z catalogue from the anime_index
+// table, which scheduled Refresh runs keep in sync with upstream so requests
+// never crawl.
+type AnimeIndexService interface {
+	GetAnimeIndex(ctx context.Context, query *request.QueryAnimeIndex) (od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry], error)
+	Refresh(ctx context.Context) error
+}
+
+type animeIndexService struct {
+	Log      *logrus.Logger
+	Validate *validator.Validate
+	Provider od_anime_entity.Provider
+	Repo     repository.AnimeIndexRepo
+	Timeout  time.Duration
+	Links    linker
+}
+
+func NewAnimeIndexService(provider od_anime_entity.Provider, repo repository.AnimeIndexRepo, validate *validator.Validate, timeout time.Duration) AnimeIndexService {
+	return &animeIndexService{
+		Log:      utils.Log,
+		Validate: validate,
+		Provider: provider,
+		Repo:     repo,
+		Timeout:  timeout,
+		Links:    newLinker(provider.Name()),
+	}
+}
+
+func (s *animeIndexService) GetAnimeIndex(ctx context.Context, query *request.QueryAnimeIndex) (od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry], error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry]{}, err
+	}
+
+	if query.Page < 1 {
+		query.Page = defaultPage
+	}
+	if query.Limit < 1 {
+		query.Limit = defaultLimit
+	}
+
+	rows, total, err := s.Repo.GetAnimeIndex(ctx, s.Provider.Name(), query)
+	if err != nil {
+		s.Log.Errorf("GetAnimeIndex failed: %+v", err)
+		return od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry]{}, err
+	}
+
+	if total == 0 {
+		indexed, err := s.Repo.CountAnimeIndex(ctx, s.Provider.Name())
+		if err != nil {
+			s.Log.Errorf("GetAnimeIndex failed: %+v", err)
+			return od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry]{}, err
+		}
+		if indexed == 0 {
+			return od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry]{}, od_anime_entity.ErrIndexNotReady
+		}
+	}
+
+	entries := make([]od_anime_entity.AnimeIndexEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, od_anime_entity.AnimeIndexEntry{
+			Title:     row.Title,
+			URL:       row.URL,
+			AnimeSlug: row.AnimeSlug,
+			Letter:    row.Letter,
+			Links:     s.Links.animeLinks(row.AnimeSlug),
+		})
+	}
+
+	return od_anime_entity.Paginated[od_anime_entity.AnimeIndexEntry]{
+		Items:        entries,
+		Page:         query.Page,
+		Limit:        query.Limit,
+		TotalPages:   (total + int64(query.Limit) - 1) / int64(query.Limit),
+		TotalResults: total,
+	}, nil
+}
+
+// Refresh crawls the upstream A–Z list and replaces the stored index with it.
+// An empty crawl is treated as a failure so a broken page never wipes the index.
+func (s *animeIndexService) Refresh(ctx context.Context) error {
+	scrapeCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	entries, err := s.Provider.ScrapeAnimeIndex(scrapeCtx)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return od_anime_entity.ErrLayoutChanged
+	}
+
+	rows := make([]model.AnimeIndex, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.AnimeSlug] {
+			continue
+		}
+		seen[entry.AnimeSlug] = true
+
+		rows = append(rows, model.AnimeIndex{
+			AnimeSlug: entry.AnimeSlug,
+			Title:     entry.Title,
+			URL:       entry.URL,
+			Letter:    entry.Letter,
+		})
+	}
+
+	return s.Repo.ReplaceAnimeIndex(ctx, s.Provider.Name(), rows)
+}
@@ -0,0 +1,138 @@
+package od_service
+
+import (
+	"net/url"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+)
+
+// linker builds links to this API's routes for one provider, mirroring the
+// routes registered by router.SourceRoutes.
+type linker struct {
+	base string
+}
+
+func newLinker(provider string) linker {
+	return linker{base: "/api/v1/sources/" + url.PathEscape(provider)}
+}
+
+func (l linker) detail(animeSlug string) string {
+	return l.base + "/detail/" + url.PathEscape(animeSlug)
+}
+
+func (l linker) play(episodeSlug string) string {
+	return l.base + "/play/" + url.PathEscape(episodeSlug)
+}
+
+func (l linker) server(episodeSlug, serverID string) string {
+	return l.play(episodeSlug) + "/server/" + url.PathEscape(serverID)
+}
+
+func (l linker) batchLink(batchSlug string) string {
+	return l.base + "/batch/" + url.PathEscape(batchSlug)
+}
+
+func (l linker) genre(genreSlug string) string {
+	return l.base + "/genre/" + url.PathEscape(genreSlug)
+}
+
+// animeLinks returns nil when slug is empty, so unresolvable items carry no links.
+func (l linker) animeLinks(slug string) od_anime_entity.Links {
+	if slug == "" {
+		return nil
+	}
+	return od_anime_entity.Links{"detail": l.detail(slug)}
+}
+
+func (l linker) genres(genres []od_anime_entity.GenreInfo) {
+	for i := range genres {
+		if genres[i].Slug != "" {
+			genres[i].Links = od_anime_entity.Links{"genre": l.genre(genres[i].Slug)}
+		}
+	}
+}
+
+func (l linker) episodes(episodes []od_anime_entity.AnimeEpisode) {
+	for i := range episodes {
+		if episodes[i].EpisodeSlug != "" {
+			episodes[i].Links = od_anime_entity.Links{"play": l.play(episodes[i].EpisodeSlug)}
+		}
+	}
+}
+
+func (l linker) animeData(animes []od_anime_entity.AnimeData) {
+	for i := range animes {
+		animes[i].Links = l.animeLinks(animes[i].AnimeSlug)
+	}
+}
+
+func (l linker) genreAnime(animes []od_anime_entity.GenreAnime) {
+	for i := range animes {
+		animes[i].Links = l.animeLinks(animes[i].AnimeSlug)
+	}
+}
+
+func (l linker) scheduleAnime(animes []od_anime_entity.ScheduleAnime) {
+	for i := range animes {
+		animes[i].Links = l.animeLinks(animes[i].AnimeSlug)
+	}
+}
+
+func (l linker) searchResults(results []od_anime_entity.SearchResult) {
+	for i := range results {
+		results[i].Links = l.animeLinks(results[i].AnimeSlug)
+		l.genres(results[i].Genres)
+	}
+}
+
+func (l linker) animeDetail(detail *od_anime_entity.AnimeDetail, episodes []od_anime_entity.AnimeEpisode) {
+	links := od_anime_entity.Links{}
+	if detail.AnimeSlug != "" {
+		links["self"] = l.detail(detail.AnimeSlug)
+	}
+	if detail.BatchSlug != "" {
+		links["batch"] = l.batchLink(detail.BatchSlug)
+	}
+	if len(links) > 0 {
+		detail.Links = links
+	}
+
+	l.genres(detail.Genres)
+	l.episodes(episodes)
+}
+
+func (l linker) streamServer(episodeSlug string, server *od_anime_entity.StreamServer) {
+	if episodeSlug != "" && server.ID != "" {
+		server.Links = od_anime_entity.Links{"self": l.server(episodeSlug, server.ID)}
+	}
+}
+
+func (l linker) batch(batch *od_anime_entity.AnimeBatch) {
+	if batch.BatchSlug != "" {
+		batch.Links = od_anime_entity.Links{"self": l.batchLink(batch.BatchSlug)}
+	}
+}
+
+func (l linker) sourceData(source *od_anime_entity.AnimeSourceData) {
+	links := od_anime_entity.Links{}
+	if source.EpisodeSlug != "" {
+		links["self"] = l.play(source.EpisodeSlug)
+	}
+	if source.AnimeSlug != "" {
+		links["anime"] = l.detail(source.AnimeSlug)
+	}
+	if source.PrevEpisodeSlug != "" {
+		links["prev"] = l.play(source.PrevEpisodeSlug)
+	}
+	if source.NextEpisodeSlug != "" {
+		links["next"] = l.play(source.NextEpisodeSlug)
+	}
+	if len(links) > 0 {
+		source.Links = links
+	}
+
+	l.episodes(source.Episodes)
+	for i := range source.StreamServers {
+		l.streamServer(source.EpisodeSlug, &source.StreamServers[i])
+	}
+}
@@ -0,0 +1,84 @@
+package od_service
+
+import (
+	"context"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 20
+)
+
+// pageQuery fills in defaults for an unset page or limit.
+func pageQuery(query *request.QueryAnimeList) (page, limit int) {
+	page, limit = query.Page, query.Limit
+	if page < 1 {
+		page = defaultPage
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+	return page, limit
+}
+
+// paginate assembles the page/limit window from upstream listing pages. The
+// first upstream page gives the upstream page size and page count; the window
+// is then filled by following upstream pages until it is full or the listing
+// ends. Until the last upstream page has been seen, TotalResults assumes it is
+// full.
+func paginate[T any](ctx context.Context, page, limit int, fetch func(ctx context.Context, page int) (od_anime_entity.ListPage[T], error)) (od_anime_entity.Paginated[T], error) {
+	result := od_anime_entity.Paginated[T]{Items: []T{}, Page: page, Limit: limit}
+
+	first, err := fetch(ctx, 1)
+	if err != nil {
+		return result, err
+	}
+
+	perPage := len(first.Items)
+	if perPage == 0 {
+		return result, nil
+	}
+
+	totalResults := perPage
+	if first.TotalPages > 1 {
+		totalResults = first.TotalPages * perPage
+	}
+
+	start := (page - 1) * limit
+	upstreamPage := start/perPage + 1
+	offset := start % perPage
+
+	current := first
+	for upstreamPage <= first.TotalPages && len(result.Items) < limit {
+		if current.Page != upstreamPage {
+			if current, err = fetch(ctx, upstreamPage); err != nil {
+				return result, err
+			}
+		}
+		if upstreamPage == first.TotalPages {
+			totalResults = (first.TotalPages-1)*perPage + len(current.Items)
+		}
+
+		if offset < len(current.Items) {
+			result.Items = append(result.Items, current.Items[offset:]...)
+		}
+		offset = 0
+
+		if !current.HasNext {
+			break
+		}
+		upstreamPage++
+	}
+
+	if len(result.Items) > limit {
+		result.Items = result.Items[:limit]
+	}
+
+	result.TotalResults = int64(totalResults)
+	result.TotalPages = int64((totalResults + limit - 1) / limit)
+
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package od_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/release"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/release"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// maxReleaseGap caps the episodes recorded for one anime by a single diff,
+// so a title that left the listings for a while doesn't flood the feed.
+const maxReleaseGap = 3
+
+// ReleaseFeedService detects new episodes by diffing successive home and
+// ongoing listings against the latest episode last seen per anime, and serves
+// the resulting release events.
+type ReleaseFeedService interface {
+	GetReleases(ctx context.Context, query *request.QueryReleaseFeed) ([]od_anime_entity.ReleaseEvent, error)
+	Track(ctx context.Context, animes []od_anime_entity.AnimeData) (int, error)
+}
+
+type releaseFeedService struct {
+	Log      *logrus.Logger
+	Validate *validator.Validate
+	Provider string
+	Repo     repository.ReleaseRepo
+	Links    linker
+}
+
+func NewReleaseFeedService(provider string, repo repository.ReleaseRepo, validate *validator.Validate) ReleaseFeedService {
+	return &releaseFeedService{
+		Log:      utils.Log,
+		Validate: validate,
+		Provider: provider,
+		Repo:     repo,
+		Links:    newLinker(provider),
+	}
+}
+
+func (s *releaseFeedService) GetReleases(ctx context.Context, query *request.QueryReleaseFeed) ([]od_anime_entity.ReleaseEvent, error) {
+	if err := s.Validate.Struct(query); err != nil {
+		return nil, err
+	}
+
+	if query.Limit < 1 {
+		query.Limit = defaultLimit
+	}
+
+	rows, err := s.Repo.GetReleaseEvents(ctx, s.Provider, query)
+	if err != nil {
+		s.Log.Errorf("GetReleases failed: %+v", err)
+		return nil, err
+	}
+
+	events := make([]od_anime_entity.ReleaseEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, od_anime_entity.ReleaseEvent{
+			ID:           row.ID,
+			Seq:          row.Seq,
+			Type:         row.Type,
+			Provider:     row.Provider,
+			Title:        row.Title,
+			URL:          row.URL,
+			AnimeSlug:    row.AnimeSlug,
+			ThumbnailURL: row.ThumbnailURL,
+			Episode:      row.Episode,
+			DetectedAt:   row.DetectedAt,
+			Links:        s.Links.animeLinks(row.AnimeSlug),
+		})
+	}
+
+	return events, nil
+}
+
+// Track diffs a freshly scraped listing and records an event for each episode
+// newer than the one last seen, returning how many were found. Anime without
+// a parsed latest episode are ignored. The very first listing of a provider
+// only sets the baseline, since everything on it would look new; so does an
+// anime new to the listings, unless it just aired its first episode.
+func (s *releaseFeedService) Track(ctx context.Context, animes []od_anime_entity.AnimeData) (int, error) {
+	rows, err := s.Repo.GetReleaseStates(ctx, s.Provider)
+	if err != nil {
+		return 0, err
+	}
+
+	baseline := len(rows) == 0
+	seen := make(map[string]int, len(rows))
+	for _, row := range rows {
+		seen[row.AnimeSlug] = row.LatestEp
+	}
+
+	now := time.Now()
+	var states []model.ReleaseState
+	var events []model.ReleaseEvent
+
+	for _, anime := range animes {
+		if anime.AnimeSlug == "" || anime.LatestEp == nil {
+			continue
+		}
+
+		latest := *anime.LatestEp
+		last, known := seen[anime.AnimeSlug]
+		if known && latest <= last {
+			continue
+		}
+		seen[anime.AnimeSlug] = latest
+
+		states = append(states, model.ReleaseState{
+			Provider:  s.Provider,
+			AnimeSlug: anime.AnimeSlug,
+			LatestEp:  latest,
+			UpdatedAt: now,
+		})
+
+		if baseline || (!known && latest != 1) {
+			continue
+		}
+
+		first := latest
+		if known {
+			first = max(last+1, latest-maxReleaseGap+1)
+		}
+		for ep := first; ep <= latest; ep++ {
+			events = append(events, model.ReleaseEvent{
+				Type:         model.EventEpisodeReleased,
+				Provider:     s.Provider,
+				AnimeSlug:    anime.AnimeSlug,
+				Episode:      ep,
+				Title:        anime.Title,
+				URL:          anime.URL,
+				ThumbnailURL: anime.ThumbnailURL,
+				DetectedAt:   now,
+			})
+		}
+	}
+
+	if len(states) == 0 {
+		return 0, nil
+	}
+	if err := s.Repo.RecordReleases(ctx, states, events); err != nil {
+		return 0, err
+	}
+
+	if len(events) > 0 {
+		s.Log.Infof("Detected %d new %s episodes", len(events), s.Provider)
+	}
+	return len(events), nil
+}
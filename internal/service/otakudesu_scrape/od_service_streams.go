@@ -0,0 +1,65 @@
+package od_service
+
+import (
+	"context"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+)
+
+// StreamLinker returns the stream proxy link for a direct video URL.
+type StreamLinker interface {
+	Link(upstreamURL string) string
+}
+
+type streamLinkedAnimeService struct {
+	AnimeService
+	Streams StreamLinker
+}
+
+// NewStreamLinkedAnimeService links every direct stream returned by next to
+// the stream proxy. It goes outside the cache so each response carries
+// freshly signed links rather than ones issued when the entry was stored.
+func NewStreamLinkedAnimeService(next AnimeService, streams StreamLinker) AnimeService {
+	return &streamLinkedAnimeService{
+		AnimeService: next,
+		Streams:      streams,
+	}
+}
+
+func (s *streamLinkedAnimeService) GetAnimeSourceVid(ctx context.Context, judul_eps string) (od_anime_entity.AnimeSourceData, error) {
+	source, err := s.AnimeService.GetAnimeSourceVid(ctx, judul_eps)
+	if err != nil {
+		return source, err
+	}
+
+	source.Streams = s.link(source.Streams)
+	return source, nil
+}
+
+func (s *streamLinkedAnimeService) GetAnimeBatch(ctx context.Context, batchSlug string) (od_anime_entity.AnimeBatch, error) {
+	batch, err := s.AnimeService.GetAnimeBatch(ctx, batchSlug)
+	if err != nil {
+		return batch, err
+	}
+
+	batch.Streams = s.link(batch.Streams)
+	return batch, nil
+}
+
+// link returns a linked copy of streams; the cache hands the same result to
+// every caller sharing an upstream fetch.
+func (s *streamLinkedAnimeService) link(streams []od_anime_entity.StreamSource) []od_anime_entity.StreamSource {
+	if streams == nil {
+		return nil
+	}
+
+	linked := make([]od_anime_entity.StreamSource, len(streams))
+	copy(linked, streams)
+
+	for i := range linked {
+		if linked[i].Direct {
+			linked[i].Links = od_anime_entity.Links{"stream": s.Streams.Link(linked[i].URL)}
+		}
+	}
+	return linked
+}
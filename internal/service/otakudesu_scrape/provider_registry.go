@@ -0,0 +1,47 @@
+package od_service
+
+import (
+	"sort"
+	"sync"
+)
+
+// ProviderRegistry maps a provider name (e.g. "otakudesu") to the AnimeService
+// serving it, so routes can target any registered source by name.
+type ProviderRegistry struct {
+	mu       sync.RWMutex
+	services map[string]AnimeService
+}
+
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{
+		services: make(map[string]AnimeService),
+	}
+}
+
+func (r *ProviderRegistry) Register(name string, svc AnimeService) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.services[name] = svc
+}
+
+func (r *ProviderRegistry) Get(name string) (AnimeService, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	svc, ok := r.services[name]
+	return svc, ok
+}
+
+func (r *ProviderRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.services))
+	for name := range r.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// viewerLimiter caps how many streams each viewer watches at once. A stream
+// stays active while a request for it is in flight and for idle after the
+// last one ended, which spans the gaps between HLS segment or Range requests.
+// Counts are kept in memory, which every request shares since the server
+// runs as a single process (see config.FiberConfig).
+type viewerLimiter struct {
+	mu        sync.Mutex
+	max       int
+	idle      time.Duration
+	viewers   map[string]map[string]*streamActivity
+	lastSweep time.Time
+}
+
+type streamActivity struct {
+	inFlight int
+	lastSeen time.Time
+}
+
+func newViewerLimiter(max int, idle time.Duration) *viewerLimiter {
+	return &viewerLimiter{
+		max:     max,
+		idle:    idle,
+		viewers: make(map[string]map[string]*streamActivity),
+	}
+}
+
+// acquire records a request of viewer for stream. It reports false when that
+// would start a new stream beyond the limit; otherwise release must be called
+// once the request is done. A max below 1 means no limit.
+func (l *viewerLimiter) acquire(viewer, stream string) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) >= l.idle {
+		l.sweep(now)
+	}
+
+	streams := l.viewers[viewer]
+	if streams == nil {
+		streams = make(map[string]*streamActivity)
+		l.viewers[viewer] = streams
+	}
+	l.prune(streams, now)
+
+	activity, watching := streams[stream]
+	if !watching {
+		if l.max > 0 && len(streams) >= l.max {
+			return nil, false
+		}
+		activity = &streamActivity{}
+		streams[stream] = activity
+	}
+
+	activity.inFlight++
+	activity.lastSeen = now
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			activity.inFlight--
+			activity.lastSeen = time.Now()
+		})
+	}, true
+}
+
+// sweep forgets viewers without active streams.
+func (l *viewerLimiter) sweep(now time.Time) {
+	for viewer, streams := range l.viewers {
+		if l.prune(streams, now); len(streams) == 0 {
+			delete(l.viewers, viewer)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (l *viewerLimiter) prune(streams map[string]*streamActivity, now time.Time) {
+	for id, activity := range streams {
+		if activity.inFlight == 0 && now.Sub(activity.lastSeen) >= l.idle {
+			delete(streams, id)
+		}
+	}
+}
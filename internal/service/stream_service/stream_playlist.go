@@ -0,0 +1,62 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var playlistURIAttr = regexp.MustCompile(`URI="([^"]*)"`)
+
+// isPlaylist reports whether an upstream response is an HLS playlist, going by
+// its content type and falling back to the .m3u8 extension.
+func isPlaylist(contentType string, upstream *url.URL) bool {
+	return strings.Contains(strings.ToLower(contentType), "mpegurl") ||
+		strings.HasSuffix(strings.ToLower(upstream.Path), ".m3u8")
+}
+
+// proxiable reports whether the proxy may fetch upstream. Only http and https
+// are; which addresses may be dialed is left to the stream client.
+func proxiable(upstream *url.URL) bool {
+	return (upstream.Scheme == "http" || upstream.Scheme == "https") && upstream.Host != ""
+}
+
+// rewritePlaylist points every URI of an HLS playlist at link: the segment and
+// variant playlist lines as well as the URI attributes of tags such as
+// #EXT-X-KEY and #EXT-X-MAP. Relative URIs are resolved against base first;
+// URIs the proxy won't fetch are left as they are.
+func rewritePlaylist(playlist []byte, base *url.URL, link func(upstreamURL string) string) []byte {
+	resolve := func(ref string) string {
+		target, err := base.Parse(strings.TrimSpace(ref))
+		if err != nil || !proxiable(target) {
+			return ref
+		}
+		return link(target.String())
+	}
+
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(playlist))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(playlist)+1)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+		case strings.HasPrefix(trimmed, "#"):
+			line = playlistURIAttr.ReplaceAllStringFunc(line, func(attr string) string {
+				return `URI="` + resolve(playlistURIAttr.FindStringSubmatch(attr)[1]) + `"`
+			})
+		default:
+			line = resolve(trimmed)
+		}
+
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+
+	return out.Bytes()
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrStreamNotFound = errors.New("stream token invalid")
+	ErrStreamExpired  = errors.New("stream token expired")
+	ErrTooManyStreams = errors.New("too many concurrent streams")
+	ErrStreamUpstream = errors.New("stream upstream unavailable")
+)
+
+// StreamService proxies direct video URLs behind signed, expiring tokens so
+// clients never talk to the video hosts themselves.
+type StreamService interface {
+	// Link returns the proxy path streaming upstreamURL.
+	Link(upstreamURL string) string
+	// Open starts streaming the upstream behind token for viewer, passing on
+	// the request's Range header. Closing the returned body ends the stream.
+	Open(c *fiber.Ctx, token, viewer string) (*Stream, error)
+}
+
+// Stream is an upstream response ready to be sent to the client.
+type Stream struct {
+	StatusCode int
+	Header     http.Header // headers to pass on to the client
+	Size       int64       // body length, -1 when unknown
+	Body       io.ReadCloser
+}
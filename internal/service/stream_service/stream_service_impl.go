@@ -0,0 +1,215 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+const maxPlaylistBytes = 4 << 20
+
+// passedHeaders are the upstream response headers clients need to seek and
+// cache the video.
+var passedHeaders = []string{
+	fiber.HeaderContentType,
+	fiber.HeaderContentRange,
+	fiber.HeaderAcceptRanges,
+	fiber.HeaderLastModified,
+	fiber.HeaderETag,
+}
+
+// Options configure the stream proxy. BasePath is the route streams are
+// served from, e.g. "/api/v1/stream". MaxPerViewer below 1 disables the
+// concurrent-stream limit.
+type Options struct {
+	Secret        string
+	BasePath      string
+	TokenTTL      time.Duration
+	MaxPerViewer  int
+	IdleWindow    time.Duration
+	HeaderTimeout time.Duration
+}
+
+type streamService struct {
+	Log      *logrus.Logger
+	Client   *http.Client
+	Tokens   tokenSigner
+	Limiter  *viewerLimiter
+	BasePath string
+	TokenTTL time.Duration
+	Timeout  time.Duration
+}
+
+// NewStreamService proxies streams through client. The client must not have
+// a Timeout, which would cut long videos off; upstreams get HeaderTimeout to
+// start answering instead.
+func NewStreamService(client *http.Client, opts Options) StreamService {
+	return &streamService{
+		Log:      utils.Log,
+		Client:   client,
+		Tokens:   tokenSigner{secret: []byte(opts.Secret)},
+		Limiter:  newViewerLimiter(opts.MaxPerViewer, opts.IdleWindow),
+		BasePath: strings.TrimSuffix(opts.BasePath, "/"),
+		TokenTTL: opts.TokenTTL,
+		Timeout:  opts.HeaderTimeout,
+	}
+}
+
+func (s *streamService) Link(upstreamURL string) string {
+	return s.link(streamClaims{
+		URL:     upstreamURL,
+		Stream:  streamID(upstreamURL),
+		Expires: time.Now().Add(s.TokenTTL).Unix(),
+	})
+}
+
+func (s *streamService) link(claims streamClaims) string {
+	return s.BasePath + "/" + s.Tokens.sign(claims)
+}
+
+func (s *streamService) Open(c *fiber.Ctx, token, viewer string) (*Stream, error) {
+	claims, err := s.Tokens.verify(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	upstream, err := url.Parse(claims.URL)
+	if err != nil || !proxiable(upstream) {
+		return nil, ErrStreamNotFound
+	}
+
+	release, ok := s.Limiter.acquire(viewer, claims.Stream)
+	if !ok {
+		return nil, ErrTooManyStreams
+	}
+
+	// Playlists are rewritten as a whole, so only media requests are ranged.
+	var rangeHeader string
+	if !isPlaylist("", upstream) {
+		rangeHeader = c.Get(fiber.HeaderRange)
+	}
+
+	res, cancel, err := s.fetch(upstream, rangeHeader)
+	if err != nil {
+		release()
+		s.Log.Warnf("Stream %s failed: %+v", upstream.Host, err)
+		return nil, fmt.Errorf("%w: %v", ErrStreamUpstream, err)
+	}
+
+	body := &streamBody{ReadCloser: res.Body, done: func() {
+		cancel()
+		release()
+	}}
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+	default:
+		body.Close()
+		s.Log.Warnf("Stream %s failed: status %d", upstream.Host, res.StatusCode)
+		return nil, fmt.Errorf("%w: status %d", ErrStreamUpstream, res.StatusCode)
+	}
+
+	if res.StatusCode == http.StatusOK && isPlaylist(res.Header.Get(fiber.HeaderContentType), upstream) {
+		return s.playlist(res, body, claims)
+	}
+
+	header := make(http.Header)
+	for _, name := range passedHeaders {
+		if value := res.Header.Get(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+
+	return &Stream{
+		StatusCode: res.StatusCode,
+		Header:     header,
+		Size:       res.ContentLength,
+		Body:       body,
+	}, nil
+}
+
+// playlist reads an HLS playlist and rewrites it so its segments are fetched
+// through the proxy as part of the same stream.
+func (s *streamService) playlist(res *http.Response, body io.ReadCloser, claims streamClaims) (*Stream, error) {
+	defer body.Close()
+
+	playlist, err := io.ReadAll(io.LimitReader(body, maxPlaylistBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStreamUpstream, err)
+	}
+	if len(playlist) > maxPlaylistBytes {
+		return nil, fmt.Errorf("%w: playlist over %d bytes", ErrStreamUpstream, maxPlaylistBytes)
+	}
+
+	rewritten := rewritePlaylist(playlist, res.Request.URL, func(upstreamURL string) string {
+		return s.link(streamClaims{URL: upstreamURL, Stream: claims.Stream, Expires: claims.Expires})
+	})
+
+	header := make(http.Header)
+	header.Set(fiber.HeaderContentType, "application/vnd.apple.mpegurl")
+	header.Set(fiber.HeaderCacheControl, "no-cache")
+
+	return &Stream{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Size:       int64(len(rewritten)),
+		Body:       io.NopCloser(bytes.NewReader(rewritten)),
+	}, nil
+}
+
+// fetch requests upstream, giving it Timeout to answer. The request is not
+// bound to the client's request context since the body is streamed after the
+// handler returns; cancel ends it once the body is closed.
+func (s *streamService) fetch(upstream *url.URL, rangeHeader string) (*http.Response, context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, upstream.String(), nil)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	if rangeHeader != "" {
+		req.Header.Set(fiber.HeaderRange, rangeHeader)
+	}
+
+	var timer *time.Timer
+	if s.Timeout > 0 {
+		timer = time.AfterFunc(s.Timeout, cancel)
+	}
+
+	res, err := s.Client.Do(req)
+	if timer != nil && !timer.Stop() && err == nil {
+		// The timeout fired as the headers arrived and cancelled the body.
+		res.Body.Close()
+		err = context.DeadlineExceeded
+	}
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return res, cancel, nil
+}
+
+// streamBody runs done once when the client is finished with the body.
+type streamBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *streamBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// streamClaims is the payload of a stream token. Stream identifies what the
+// viewer is watching: the URL of the first token issued for it, shared by the
+// segment tokens of an HLS playlist so they count as a single stream.
+type streamClaims struct {
+	URL     string `json:"u"`
+	Stream  string `json:"s"`
+	Expires int64  `json:"e"`
+}
+
+// tokenSigner issues and checks stream tokens: base64url JSON claims and
+// their HMAC-SHA256, joined by a dot.
+type tokenSigner struct {
+	secret []byte
+}
+
+func (s tokenSigner) sign(claims streamClaims) string {
+	payload, _ := json.Marshal(claims)
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded))
+}
+
+func (s tokenSigner) verify(token string, now time.Time) (streamClaims, error) {
+	var claims streamClaims
+
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return claims, ErrStreamNotFound
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.mac(encoded)) {
+		return claims, ErrStreamNotFound
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || json.Unmarshal(payload, &claims) != nil || claims.URL == "" {
+		return claims, ErrStreamNotFound
+	}
+
+	if now.Unix() >= claims.Expires {
+		return claims, ErrStreamExpired
+	}
+	return claims, nil
+}
+
+func (s tokenSigner) mac(payload string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// streamID names the stream rooted at rawURL.
+func streamID(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:8])
+}
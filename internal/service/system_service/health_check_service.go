@@ -4,6 +4,7 @@ import (
 	"errors"
 	"runtime"
 
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
 
 	"github.com/sirupsen/logrus"
@@ -13,17 +14,20 @@ import (
 type HealthCheckService interface {
 	GormCheck() error
 	MemoryHeapCheck() error
+	UpstreamCheck() []httpclient.BreakerStatus
 }
 
 type healthCheckService struct {
-	Log *logrus.Logger
-	DB  *gorm.DB
+	Log      *logrus.Logger
+	DB       *gorm.DB
+	Breakers *httpclient.BreakerSet
 }
 
-func NewHealthCheckService(db *gorm.DB) HealthCheckService {
+func NewHealthCheckService(db *gorm.DB, breakers *httpclient.BreakerSet) HealthCheckService {
 	return &healthCheckService{
-		Log: utils.Log,
-		DB:  db,
+		Log:      utils.Log,
+		DB:       db,
+		Breakers: breakers,
 	}
 }
 
@@ -60,3 +64,19 @@ func (s *healthCheckService) MemoryHeapCheck() error {
 
 	return nil
 }
+
+// UpstreamCheck reports the circuit breaker state of every scraped upstream
+func (s *healthCheckService) UpstreamCheck() []httpclient.BreakerStatus {
+	if s.Breakers == nil {
+		return nil
+	}
+
+	statuses := s.Breakers.Statuses()
+	for _, status := range statuses {
+		if status.State != httpclient.BreakerClosed {
+			s.Log.Warnf("Upstream %s circuit is %s after %d failures", status.Name, status.State, status.Failures)
+		}
+	}
+
+	return statuses
+}
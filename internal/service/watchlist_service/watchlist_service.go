@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+)
+
+type WatchlistService interface {
+	GetWatchlist(c *fiber.Ctx, userID uuid.UUID, params *request.QueryWatchlist) ([]model.WatchlistItem, int64, error)
+	GetWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string) (*model.WatchlistItem, error)
+	AddWatchlistItem(c *fiber.Ctx, userID uuid.UUID, req *request.CreateWatchlist) (*model.WatchlistItem, error)
+	UpdateWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string, req *request.UpdateWatchlist) (*model.WatchlistItem, error)
+	DeleteWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string) error
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/watchlist"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/convert_types"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type watchlistService struct {
+	Log           *logrus.Logger
+	Validate      *validator.Validate
+	Provider      string
+	WatchlistRepo repository.WatchlistRepo
+}
+
+// NewWatchlistService keeps watchlists of anime from provider.
+func NewWatchlistService(provider string, watchlistRepo repository.WatchlistRepo, validate *validator.Validate) WatchlistService {
+	return &watchlistService{
+		Log:           utils.Log,
+		Validate:      validate,
+		Provider:      provider,
+		WatchlistRepo: watchlistRepo,
+	}
+}
+
+func (s *watchlistService) GetWatchlist(c *fiber.Ctx, userID uuid.UUID, params *request.QueryWatchlist) ([]model.WatchlistItem, int64, error) {
+	if err := s.Validate.Struct(params); err != nil {
+		return nil, 0, err
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 20
+	}
+
+	items, total, err := s.WatchlistRepo.GetWatchlist(c.Context(), userID, params)
+	if err != nil {
+		s.Log.Errorf("GetWatchlist failed: %+v", err)
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, "Get watchlist failed")
+	}
+
+	return items, total, nil
+}
+
+func (s *watchlistService) GetWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string) (*model.WatchlistItem, error) {
+	item, err := s.WatchlistRepo.GetWatchlistItem(c.Context(), userID, s.Provider, slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Anime is not on the watchlist")
+	}
+
+	if err != nil {
+		s.Log.Errorf("GetWatchlistItem failed: %+v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Get watchlist item failed")
+	}
+
+	return item, nil
+}
+
+func (s *watchlistService) AddWatchlistItem(c *fiber.Ctx, userID uuid.UUID, req *request.CreateWatchlist) (*model.WatchlistItem, error) {
+	if err := s.Validate.Struct(req); err != nil {
+		return nil, err
+	}
+
+	if req.Status == "" {
+		req.Status = model.StatusPlanToWatch
+	}
+
+	item := convert_types.CreateWatchlistToWatchlistModel(userID, s.Provider, req)
+
+	err := s.WatchlistRepo.CreateWatchlistItem(c.Context(), item)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, fiber.NewError(fiber.StatusConflict, "Anime is already on the watchlist")
+	}
+
+	if err != nil {
+		s.Log.Errorf("AddWatchlistItem failed: %+v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Add watchlist item failed")
+	}
+
+	return item, nil
+}
+
+func (s *watchlistService) UpdateWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string, req *request.UpdateWatchlist) (*model.WatchlistItem, error) {
+	if err := s.Validate.Struct(req); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetWatchlistItem(c, userID, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil {
+		item.Status = *req.Status
+	}
+	if req.Score != nil {
+		item.Score = req.Score
+		if *req.Score == 0 {
+			item.Score = nil
+		}
+	}
+	if req.Notes != nil {
+		item.Notes = *req.Notes
+	}
+
+	err = s.WatchlistRepo.UpdateWatchlistItem(c.Context(), item)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Anime is not on the watchlist")
+	}
+
+	if err != nil {
+		s.Log.Errorf("UpdateWatchlistItem failed: %+v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Update watchlist item failed")
+	}
+
+	return item, nil
+}
+
+func (s *watchlistService) DeleteWatchlistItem(c *fiber.Ctx, userID uuid.UUID, slug string) error {
+	err := s.WatchlistRepo.DeleteWatchlistItem(c.Context(), userID, s.Provider, slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "Anime is not on the watchlist")
+	}
+
+	if err != nil {
+		s.Log.Errorf("DeleteWatchlistItem failed: %+v", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Delete watchlist item failed")
+	}
+
+	return nil
+}
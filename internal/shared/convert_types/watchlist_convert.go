@@ -0,0 +1,18 @@
+package convert_types
+
+import (
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+)
+
+func CreateWatchlistToWatchlistModel(userID uuid.UUID, provider string, item *request.CreateWatchlist) *model.WatchlistItem {
+	return &model.WatchlistItem{
+		UserID:    userID,
+		Provider:  provider,
+		AnimeSlug: item.AnimeSlug,
+		Status:    item.Status,
+		Score:     item.Score,
+		Notes:     item.Notes,
+	}
+}
@@ -0,0 +1,115 @@
+package otakudesu
+
+import (
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+)
+
+//go:embed *.html
+var pages embed.FS
+
+// routes maps upstream paths to the golden page served for them.
+var routes = map[string]string{
+	"/":                                "home.html",
+	"/ongoing-anime/page/1":            "ongoing.html",
+	"/complete-anime/page/2":           "completed.html",
+	"/anime-list":                      "anime-list.html",
+	"/genre-list":                      "genre-list.html",
+	"/jadwal-rilis":                    "schedule.html",
+	"/genres/adventure/page/1":         "genre.html",
+	"/anime/zatsu-tabi-sub-indo":       "detail.html",
+	"/episode/zttj-episode-2-sub-indo": "episode.html",
+	"/pdrain/zttj-2-360":               "pdrain.html",
+	"/pdrain/zttj-2-720":               "pdrain.html",
+	"/batch/zatsu-tabi-batch-sub-indo": "batch.html",
+	"/pdrain/zttj-batch-720":           "pdrain.html",
+	"/anime/layout-changed":            "blank.html",
+}
+
+// Admin-ajax actions of the episode player, see scrape_otakudesu/server.go.
+const (
+	nonceAction = "aa1208d27f29ca340c92c66d1926f13f"
+	embedAction = "2a3505c93b0035d3f455df82bf976b84"
+	ajaxNonce   = "fixture-nonce"
+)
+
+// players maps the "<id>-<q>-<i>" of the episode's stream servers to the
+// player page they embed. Servers missing here fail to resolve.
+var players = map[string]string{
+	"2002-360p-0": "/embed/zttj-2-360-ondesu",
+	"2002-720p-0": "/embed/zttj-2-720-ondesu",
+}
+
+// ajax answers the player's admin-ajax calls the way WordPress does,
+// including its bare "0" for requests it can't serve.
+func ajax(w http.ResponseWriter, r *http.Request, baseURL string) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var data string
+	switch r.PostForm.Get("action") {
+	case nonceAction:
+		data = ajaxNonce
+	case embedAction:
+		player, ok := players[r.PostForm.Get("id")+"-"+r.PostForm.Get("q")+"-"+r.PostForm.Get("i")]
+		if !ok || r.PostForm.Get("nonce") != ajaxNonce {
+			http.Error(w, "0", http.StatusBadRequest)
+			return
+		}
+		data = base64.StdEncoding.EncodeToString([]byte(`<iframe src="` + baseURL + player + `" allowfullscreen></iframe>`))
+	default:
+		http.Error(w, "0", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	_ = json.NewEncoder(w).Encode(map[string]string{"data": data})
+}
+
+// NewServer serves the golden otakudesu pages in this directory.
+// Every {{BASE_URL}} in a page is replaced with the server URL so links stay local.
+func NewServer() *httptest.Server {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		urlPath := strings.TrimSuffix(r.URL.Path, "/")
+		if urlPath == "" {
+			urlPath = "/"
+		}
+
+		if urlPath == "/wp-admin/admin-ajax.php" && r.Method == http.MethodPost {
+			ajax(w, r, server.URL)
+			return
+		}
+
+		page, ok := routes[urlPath]
+		if urlPath == "/" && r.URL.Query().Has("s") {
+			page, ok = "search.html", true
+		}
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		body, err := pages.ReadFile(page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		html := strings.ReplaceAll(string(body), "{{BASE_URL}}", server.URL)
+		html = strings.ReplaceAll(html, "{{FILE_ID}}", path.Base(urlPath))
+
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		_, _ = w.Write([]byte(html))
+	}))
+
+	return server
+}
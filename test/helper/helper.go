@@ -6,6 +6,7 @@ import (
 
 	"github.com/muhammadsaefulr/NimeStreamAPI/config"
 
+	release_model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/release"
 	token_model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/token"
 	user_model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/user"
 	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/utils"
@@ -35,6 +36,15 @@ func ClearToken(db *gorm.DB) {
 	}
 }
 
+func ClearReleases(db *gorm.DB) {
+	if err := db.Where("provider is not null").Delete(&release_model.ReleaseEvent{}).Error; err != nil {
+		logrus.Fatalf("Failed clear release events : %+v", err)
+	}
+	if err := db.Where("provider is not null").Delete(&release_model.ReleaseState{}).Error; err != nil {
+		logrus.Fatalf("Failed clear release states : %+v", err)
+	}
+}
+
 func CreateUser(db *gorm.DB, email, password, name string) {
 	hashedPassword, err := utils.HashPassword(password)
 	if err != nil {
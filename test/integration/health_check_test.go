@@ -48,6 +48,16 @@ func TestHealthCheckRoutes(t *testing.T) {
 					Status: "Up",
 					IsUp:   true,
 				},
+				{
+					Name:   "Otakudesu",
+					Status: "Up",
+					IsUp:   true,
+				},
+				{
+					Name:   "Pdrain",
+					Status: "Up",
+					IsUp:   true,
+				},
 			}, responseBody.Result)
 		})
 
@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	repository "github.com/muhammadsaefulr/NimeStreamAPI/internal/repository/release"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+	"github.com/muhammadsaefulr/NimeStreamAPI/test"
+	"github.com/muhammadsaefulr/NimeStreamAPI/test/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func listing(latestEp int) []od_anime_entity.AnimeData {
+	animes := make([]od_anime_entity.AnimeData, 0, 9)
+	for i := range 9 {
+		ep := latestEp
+		animes = append(animes, od_anime_entity.AnimeData{
+			Title:     fmt.Sprintf("Anime %d", i),
+			AnimeSlug: fmt.Sprintf("anime-%d-sub-indo", i),
+			LatestEp:  &ep,
+		})
+	}
+	return animes
+}
+
+func TestReleaseRepo(t *testing.T) {
+	helper.ClearReleases(test.DB)
+	defer helper.ClearReleases(test.DB)
+
+	ctx := context.Background()
+	repo := repository.NewReleaseRepoImpl(test.DB)
+	feed := od_service.NewReleaseFeedService("otakudesu", repo, validation.Validator())
+
+	start := time.Now().Add(-time.Minute)
+	_, err := feed.Track(ctx, listing(1))
+	assert.Nil(t, err)
+
+	// One diff records 27 events, more than fit on a page.
+	found, err := feed.Track(ctx, listing(4))
+	assert.Nil(t, err)
+	assert.Equal(t, 27, found)
+
+	t.Run("should return the newest events first without a cursor", func(t *testing.T) {
+		events, err := repo.GetReleaseEvents(ctx, "otakudesu", &request.QueryReleaseFeed{Limit: 10})
+		assert.Nil(t, err)
+		assert.Len(t, events, 10)
+		for i := 1; i < len(events); i++ {
+			assert.Less(t, events[i].Seq, events[i-1].Seq)
+		}
+	})
+
+	t.Run("should page forward by seq without missing events", func(t *testing.T) {
+		seen := map[string]bool{}
+		query := &request.QueryReleaseFeed{Since: start, Limit: 10}
+		for pages := 0; pages < 5; pages++ {
+			events, err := repo.GetReleaseEvents(ctx, "otakudesu", query)
+			assert.Nil(t, err)
+			if len(events) == 0 {
+				break
+			}
+
+			for _, event := range events {
+				assert.False(t, seen[event.ID], "event %s returned twice", event.ID)
+				assert.Greater(t, event.Seq, query.After)
+				seen[event.ID] = true
+				query = &request.QueryReleaseFeed{After: event.Seq, Limit: 10}
+			}
+		}
+		assert.Len(t, seen, 27)
+	})
+}
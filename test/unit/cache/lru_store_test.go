@@ -0,0 +1,69 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/cache"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newEntry(value string, ttl time.Duration) *cache.Entry {
+	now := time.Now()
+	return &cache.Entry{Value: []byte(value), StoredAt: now, ExpiresAt: now.Add(ttl)}
+}
+
+func TestLRUStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return stored entry before it expires", func(t *testing.T) {
+		store := cache.NewLRUStore(2)
+		assert.Nil(t, store.Set(ctx, "home", newEntry("a", time.Minute)))
+
+		entry, err := store.Get(ctx, "home")
+		assert.Nil(t, err)
+		assert.Equal(t, "a", string(entry.Value))
+	})
+
+	t.Run("should miss on expired entry", func(t *testing.T) {
+		store := cache.NewLRUStore(2)
+		assert.Nil(t, store.Set(ctx, "home", newEntry("a", -time.Second)))
+
+		_, err := store.Get(ctx, "home")
+		assert.ErrorIs(t, err, cache.ErrMiss)
+	})
+
+	t.Run("should evict least recently used entry when full", func(t *testing.T) {
+		store := cache.NewLRUStore(2)
+		assert.Nil(t, store.Set(ctx, "a", newEntry("a", time.Minute)))
+		assert.Nil(t, store.Set(ctx, "b", newEntry("b", time.Minute)))
+
+		_, err := store.Get(ctx, "a")
+		assert.Nil(t, err)
+
+		assert.Nil(t, store.Set(ctx, "c", newEntry("c", time.Minute)))
+
+		_, err = store.Get(ctx, "b")
+		assert.ErrorIs(t, err, cache.ErrMiss)
+
+		_, err = store.Get(ctx, "a")
+		assert.Nil(t, err)
+		_, err = store.Get(ctx, "c")
+		assert.Nil(t, err)
+	})
+
+	t.Run("should delete only expired entries", func(t *testing.T) {
+		store := cache.NewLRUStore(3)
+		assert.Nil(t, store.Set(ctx, "a", newEntry("a", -time.Second)))
+		assert.Nil(t, store.Set(ctx, "b", newEntry("b", time.Minute)))
+
+		deleted, err := store.DeleteExpired(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		_, err = store.Get(ctx, "b")
+		assert.Nil(t, err)
+	})
+}
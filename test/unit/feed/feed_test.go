@@ -0,0 +1,136 @@
+package feed_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	controller "github.com/muhammadsaefulr/NimeStreamAPI/internal/delivery/http/controller/feed_controller"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubReleaseFeed serves fixed events and remembers the last query.
+type stubReleaseFeed struct {
+	events []od_anime_entity.ReleaseEvent
+	query  *request.QueryReleaseFeed
+}
+
+func (s *stubReleaseFeed) GetReleases(_ context.Context, query *request.QueryReleaseFeed) ([]od_anime_entity.ReleaseEvent, error) {
+	s.query = query
+	return s.events, nil
+}
+
+func (s *stubReleaseFeed) Track(context.Context, []od_anime_entity.AnimeData) (int, error) {
+	return 0, nil
+}
+
+func newFeedApp(feed *stubReleaseFeed) *fiber.App {
+	feedController := controller.NewFeedController(feed)
+
+	app := fiber.New()
+	app.Get("/api/v1/feed/releases", feedController.GetReleases)
+	app.Get("/api/v1/feed/releases.rss", feedController.GetReleasesRSS)
+	app.Get("/api/v1/feed/releases.atom", feedController.GetReleasesAtom)
+	return app
+}
+
+func get(t *testing.T, app *fiber.App, link string) (*http.Response, []byte) {
+	res, err := app.Test(httptest.NewRequest(http.MethodGet, link, nil), -1)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	return res, body
+}
+
+var detectedAt = time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC)
+
+func newStub() *stubReleaseFeed {
+	return &stubReleaseFeed{events: []od_anime_entity.ReleaseEvent{{
+		ID:         "0b6f3c1e-7c59-4c4e-9d4a-1f6f3f0c2a11",
+		Title:      "Dr. Stone Season 4",
+		URL:        "https://otakudesu.cloud/anime/dr-stone-s4-sub-indo/",
+		AnimeSlug:  "dr-stone-s4-sub-indo",
+		Episode:    8,
+		DetectedAt: detectedAt,
+		Links:      od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/dr-stone-s4-sub-indo"},
+	}}}
+}
+
+func TestFeedQuery(t *testing.T) {
+	t.Run("should pass since and limit on", func(t *testing.T) {
+		feed := newStub()
+		res, _ := get(t, newFeedApp(feed), "/api/v1/feed/releases?since=2026-10-16T08:00:00Z&limit=5")
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 5, feed.query.Limit)
+		assert.True(t, feed.query.Since.Equal(time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("should pass after on", func(t *testing.T) {
+		feed := newStub()
+		res, _ := get(t, newFeedApp(feed), "/api/v1/feed/releases?after=120")
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int64(120), feed.query.After)
+	})
+
+	t.Run("should reject a malformed after", func(t *testing.T) {
+		res, _ := get(t, newFeedApp(newStub()), "/api/v1/feed/releases?after=latest")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("should reject a malformed since", func(t *testing.T) {
+		res, _ := get(t, newFeedApp(newStub()), "/api/v1/feed/releases.rss?since=yesterday")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func TestFeedRSS(t *testing.T) {
+	res, body := get(t, newFeedApp(newStub()), "/api/v1/feed/releases.rss")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", res.Header.Get(fiber.HeaderContentType))
+
+	var feed struct {
+		Version string `xml:"version,attr"`
+		Items   []struct {
+			Title   string `xml:"title"`
+			Link    string `xml:"link"`
+			GUID    string `xml:"guid"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"channel>item"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &feed))
+	assert.Equal(t, "2.0", feed.Version)
+	assert.Len(t, feed.Items, 1)
+	assert.Contains(t, feed.Items[0].Title, "Dr. Stone Season 4")
+	assert.Equal(t, "https://otakudesu.cloud/anime/dr-stone-s4-sub-indo/", feed.Items[0].Link)
+	assert.Equal(t, "urn:uuid:0b6f3c1e-7c59-4c4e-9d4a-1f6f3f0c2a11", feed.Items[0].GUID)
+	assert.Equal(t, detectedAt.Format(time.RFC1123Z), feed.Items[0].PubDate)
+}
+
+func TestFeedAtom(t *testing.T) {
+	res, body := get(t, newFeedApp(newStub()), "/api/v1/feed/releases.atom")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/atom+xml; charset=utf-8", res.Header.Get(fiber.HeaderContentType))
+
+	var feed struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Updated string `xml:"updated"`
+		} `xml:"entry"`
+	}
+	assert.NoError(t, xml.Unmarshal(body, &feed))
+	assert.Len(t, feed.Entries, 1)
+	assert.Equal(t, "urn:uuid:0b6f3c1e-7c59-4c4e-9d4a-1f6f3f0c2a11", feed.Entries[0].ID)
+	assert.Equal(t, detectedAt.Format(time.RFC3339), feed.Entries[0].Updated)
+	assert.Equal(t, detectedAt.Format(time.RFC3339), feed.Updated)
+}
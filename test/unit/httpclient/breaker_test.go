@@ -0,0 +1,132 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBreakerClient(t *testing.T, serverURL string, cooldown time.Duration) (*http.Client, *httpclient.BreakerSet) {
+	target, err := url.Parse(serverURL)
+	assert.NoError(t, err)
+
+	breakers := httpclient.NewBreakerSet(2, cooldown)
+	breakers.Track("Upstream", target.Hostname())
+
+	client, err := httpclient.New(httpclient.Config{Breakers: breakers})
+	assert.NoError(t, err)
+
+	return client, breakers
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, breakers := newBreakerClient(t, server.URL, time.Minute)
+
+	for range 2 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	_, err := client.Get(server.URL)
+	assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load())
+
+	status := breakers.Statuses()[0]
+	assert.Equal(t, "Upstream", status.Name)
+	assert.Equal(t, httpclient.BreakerOpen, status.State)
+	assert.Equal(t, 2, status.Failures)
+	assert.False(t, status.RetryAt.IsZero())
+}
+
+func TestBreakerHalfOpensAfterCooldown(t *testing.T) {
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	client, breakers := newBreakerClient(t, server.URL, 20*time.Millisecond)
+
+	for range 2 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+	assert.Equal(t, httpclient.BreakerOpen, breakers.Statuses()[0].State)
+
+	// A failed probe re-opens the circuit for another cooldown.
+	time.Sleep(30 * time.Millisecond)
+	res, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, httpclient.BreakerOpen, breakers.Statuses()[0].State)
+
+	_, err = client.Get(server.URL)
+	assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+
+	// A successful probe closes it again.
+	healthy.Store(true)
+	time.Sleep(30 * time.Millisecond)
+	res, err = client.Get(server.URL)
+	assert.NoError(t, err)
+	res.Body.Close()
+
+	status := breakers.Statuses()[0]
+	assert.Equal(t, httpclient.BreakerClosed, status.State)
+	assert.Equal(t, 0, status.Failures)
+}
+
+func TestBreakerIgnoresClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, breakers := newBreakerClient(t, server.URL, time.Minute)
+
+	for range 3 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, httpclient.BreakerClosed, breakers.Statuses()[0].State)
+}
+
+func TestBreakerSkipsUntrackedHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	breakers := httpclient.NewBreakerSet(1, time.Minute)
+	breakers.Track("Other", "example.invalid")
+
+	client, err := httpclient.New(httpclient.Config{Breakers: breakers})
+	assert.NoError(t, err)
+
+	for range 2 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, httpclient.BreakerClosed, breakers.Statuses()[0].State)
+}
@@ -0,0 +1,158 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{MaxRetries: 2, RetryBaseDelay: time.Millisecond})
+	assert.NoError(t, err)
+
+	res, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{MaxRetries: 1, RetryBaseDelay: time.Millisecond})
+	assert.NoError(t, err)
+
+	res, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{MaxRetries: 3, RetryBaseDelay: time.Millisecond})
+	assert.NoError(t, err)
+
+	res, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientDoesNotRetryPosts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{MaxRetries: 3, RetryBaseDelay: time.Millisecond})
+	assert.NoError(t, err)
+
+	res, err := client.PostForm(server.URL, url.Values{"action": {"2a3505c93b0035d3f455df82bf976b84"}})
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientRotatesUserAgents(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.UserAgent())
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{UserAgents: []string{"agent-a", "agent-b"}})
+	assert.NoError(t, err)
+
+	for range 3 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, []string{"agent-a", "agent-b", "agent-a"}, seen)
+}
+
+func TestClientKeepsCookies(t *testing.T) {
+	var cookie string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("session"); err == nil {
+			cookie = c.Value
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{})
+	assert.NoError(t, err)
+
+	for range 2 {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, "abc", cookie)
+}
+
+func TestClientRejectsInvalidProxy(t *testing.T) {
+	_, err := httpclient.New(httpclient.Config{ProxyURLs: []string{"::not-a-url"}})
+
+	assert.Error(t, err)
+}
+
+func TestClientPublicOnlyRefusesPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := httpclient.New(httpclient.Config{PublicOnly: true})
+	assert.NoError(t, err)
+
+	for _, target := range []string{server.URL, "http://169.254.169.254/latest/meta-data/", "http://[::1]:1/"} {
+		_, err = client.Get(target)
+		assert.ErrorIs(t, err, httpclient.ErrPrivateAddress, target)
+	}
+	assert.Equal(t, int32(0), calls.Load())
+}
@@ -0,0 +1,164 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeResolver resolves every page to pageURL + "/file", counting calls and
+// the most resolves it saw running at once.
+type fakeResolver struct {
+	delay   time.Duration
+	err     error
+	calls   atomic.Int32
+	running atomic.Int32
+	peak    atomic.Int32
+}
+
+func (*fakeResolver) Name() string {
+	return "fake"
+}
+
+func (*fakeResolver) Hosts() []string {
+	return []string{"fake.example"}
+}
+
+func (f *fakeResolver) Resolve(ctx context.Context, _ *http.Client, pageURL string) (string, error) {
+	f.calls.Add(1)
+	running := f.running.Add(1)
+	defer f.running.Add(-1)
+	for {
+		peak := f.peak.Load()
+		if running <= peak || f.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	if f.err != nil {
+		return "", f.err
+	}
+	return pageURL + "/file", nil
+}
+
+func newRegistry(fake *fakeResolver, opts resolver.Options) *resolver.Registry {
+	registry := resolver.NewRegistry(http.DefaultClient)
+	registry.Register(fake, opts)
+	return registry
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := newRegistry(&fakeResolver{}, resolver.DefaultOptions)
+
+	tests := []struct {
+		name      string
+		mirror    string
+		pageURL   string
+		supported bool
+	}{
+		{name: "host", mirror: "Other", pageURL: "https://fake.example/u/1", supported: true},
+		{name: "subdomain", mirror: "Other", pageURL: "https://cdn.fake.example/u/1", supported: true},
+		{name: "mirror name", mirror: " FAKE ", pageURL: "https://redirect.example/u/1", supported: true},
+		{name: "unknown", mirror: "Mega", pageURL: "https://mega.nz/file/1", supported: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.supported, registry.Supports(tt.mirror, tt.pageURL))
+		})
+	}
+
+	_, err := registry.Resolve(context.Background(), "Mega", "https://mega.nz/file/1")
+	assert.ErrorIs(t, err, resolver.ErrNoResolver)
+}
+
+func TestRegistryCachesResolvedLinks(t *testing.T) {
+	fake := &fakeResolver{}
+	registry := newRegistry(fake, resolver.DefaultOptions)
+
+	for range 2 {
+		direct, err := registry.Resolve(context.Background(), "fake", "https://fake.example/u/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://fake.example/u/1/file", direct)
+	}
+	assert.Equal(t, int32(1), fake.calls.Load())
+}
+
+func TestRegistryDoesNotCacheFailures(t *testing.T) {
+	fake := &fakeResolver{err: errors.New("boom")}
+	registry := newRegistry(fake, resolver.DefaultOptions)
+
+	for range 2 {
+		_, err := registry.Resolve(context.Background(), "fake", "https://fake.example/u/1")
+		assert.EqualError(t, err, "boom")
+	}
+	assert.Equal(t, int32(2), fake.calls.Load())
+}
+
+func TestRegistryLimitsConcurrentResolves(t *testing.T) {
+	fake := &fakeResolver{delay: 20 * time.Millisecond}
+	registry := newRegistry(fake, resolver.Options{MaxConcurrent: 2, CacheTTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := registry.Resolve(context.Background(), "fake", "https://fake.example/u/"+string(rune('a'+i)))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(6), fake.calls.Load())
+	assert.Equal(t, int32(2), fake.peak.Load())
+}
+
+func TestRegistryTimesOutSlowResolves(t *testing.T) {
+	fake := &fakeResolver{delay: time.Second}
+	registry := newRegistry(fake, resolver.Options{Timeout: 20 * time.Millisecond})
+
+	_, err := registry.Resolve(context.Background(), "fake", "https://fake.example/u/1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPdrainResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/u/ok":
+			w.Write([]byte(`<html><head><meta name="twitter:player:stream" content="https://pixeldrain.com/api/file/ok"></head></html>`))
+		case "/u/empty":
+			w.Write([]byte(`<html><head></head></html>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	pdrain := resolver.NewPdrainResolver()
+
+	direct, err := pdrain.Resolve(context.Background(), server.Client(), server.URL+"/u/ok")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://pixeldrain.com/api/file/ok", direct)
+
+	_, err = pdrain.Resolve(context.Background(), server.Client(), server.URL+"/u/empty")
+	assert.ErrorIs(t, err, resolver.ErrNoStream)
+
+	_, err = pdrain.Resolve(context.Background(), server.Client(), server.URL+"/u/missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2026, time.October, 14, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2026, time.October, 14, 10, 8, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2026, time.October, 14, 10, 15, 0, 0, time.UTC)},
+		{spec: "5/20 * * * *", want: time.Date(2026, time.October, 14, 10, 25, 0, 0, time.UTC)},
+		{spec: "0 * * * *", want: time.Date(2026, time.October, 14, 11, 0, 0, 0, time.UTC)},
+		{spec: "@hourly", want: time.Date(2026, time.October, 14, 11, 0, 0, 0, time.UTC)},
+		{spec: "30 3 * * *", want: time.Date(2026, time.October, 15, 3, 30, 0, 0, time.UTC)},
+		{spec: "0 9-17/4 * * mon-fri", want: time.Date(2026, time.October, 14, 13, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * sun", want: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", want: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 1 jan,jul *", want: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// With both day fields restricted either one matches.
+		{spec: "0 0 20 * fri", want: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := scheduler.Parse(tt.spec)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
+
+func TestCronNeverRuns(t *testing.T) {
+	schedule, err := scheduler.Parse("0 0 30 2 *")
+	assert.Nil(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
+
+func TestCronParseErrors(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := scheduler.Parse(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/job_run"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryHistory keeps the job runs in memory, by ID.
+type memoryHistory struct {
+	mu   sync.Mutex
+	runs map[string]model.JobRun
+}
+
+func newMemoryHistory() *memoryHistory {
+	return &memoryHistory{runs: map[string]model.JobRun{}}
+}
+
+func (h *memoryHistory) CreateJobRun(_ context.Context, run *model.JobRun) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	run.ID = run.Job + "-" + time.Now().Format(time.RFC3339Nano)
+	h.runs[run.ID] = *run
+	return nil
+}
+
+func (h *memoryHistory) UpdateJobRun(_ context.Context, run *model.JobRun) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.runs[run.ID] = *run
+	return nil
+}
+
+func (h *memoryHistory) byStatus(status string) []model.JobRun {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var runs []model.JobRun
+	for _, run := range h.runs {
+		if run.Status == status {
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+// newScheduler returns a scheduler whose clock is just short of a full
+// minute, so "* * * * *" jobs run right away.
+func newScheduler(history scheduler.History, opts scheduler.Options) *scheduler.Scheduler {
+	jobs := scheduler.New(history, opts)
+
+	now := time.Now()
+	offset := now.Truncate(time.Minute).Add(time.Minute - 20*time.Millisecond).Sub(now)
+	jobs.Now = func() time.Time { return time.Now().Add(offset) }
+
+	return jobs
+}
+
+func TestSchedulerRecordsRuns(t *testing.T) {
+	history := newMemoryHistory()
+	jobs := newScheduler(history, scheduler.Options{MaxConcurrent: 1})
+
+	done := make(chan struct{}, 2)
+	assert.Nil(t, jobs.Add("ok", "* * * * *", func(ctx context.Context) error {
+		done <- struct{}{}
+		return nil
+	}))
+	assert.Nil(t, jobs.Add("broken", "* * * * *", func(ctx context.Context) error {
+		defer func() { done <- struct{}{} }()
+		panic("boom")
+	}))
+	assert.Nil(t, jobs.Add("disabled", "", func(ctx context.Context) error {
+		t.Error("disabled job ran")
+		return nil
+	}))
+
+	jobs.Start()
+	assert.ErrorIs(t, jobs.Add("late", "* * * * *", nil), scheduler.ErrAlreadyStarted)
+
+	timeout := time.After(time.Second)
+	for range 2 {
+		select {
+		case <-done:
+		case <-timeout:
+			t.Fatal("jobs didn't run")
+		}
+	}
+	assert.Nil(t, jobs.Stop(context.Background()))
+
+	succeeded := history.byStatus(model.JobRunSuccess)
+	assert.Len(t, succeeded, 1)
+	assert.Equal(t, "ok", succeeded[0].Job)
+	assert.NotNil(t, succeeded[0].FinishedAt)
+
+	failed := history.byStatus(model.JobRunFailed)
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "panic: boom", failed[0].Error)
+}
+
+func TestSchedulerStopCancelsRunningJobs(t *testing.T) {
+	history := newMemoryHistory()
+	jobs := newScheduler(history, scheduler.Options{MaxConcurrent: 1})
+
+	var running, finished atomic.Int32
+	started := make(chan struct{}, 2)
+	slow := func(ctx context.Context) error {
+		running.Add(1)
+		started <- struct{}{}
+		<-ctx.Done()
+		finished.Add(1)
+		return ctx.Err()
+	}
+	assert.Nil(t, jobs.Add("slow-1", "* * * * *", slow))
+	assert.Nil(t, jobs.Add("slow-2", "* * * * *", slow))
+	jobs.Start()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("job didn't run")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := jobs.Stop(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// The second job waited for the only slot and never started.
+	assert.Equal(t, int32(1), running.Load())
+	assert.Equal(t, int32(1), finished.Load())
+
+	failed := history.byStatus(model.JobRunFailed)
+	assert.Len(t, failed, 1)
+	assert.Equal(t, context.Canceled.Error(), failed[0].Error)
+}
+
+func TestSchedulerStopBeforeStart(t *testing.T) {
+	jobs := scheduler.New(newMemoryHistory(), scheduler.Options{})
+	assert.Nil(t, jobs.Add("never", "* * * * *", func(ctx context.Context) error { return nil }))
+	assert.Nil(t, jobs.Stop(context.Background()))
+}
+
+func TestSchedulerRejectsBadSpecs(t *testing.T) {
+	jobs := scheduler.New(newMemoryHistory(), scheduler.Options{})
+	assert.Error(t, jobs.Add("bad", "every minute", nil))
+}
@@ -0,0 +1,205 @@
+package scraper_test
+
+import (
+	"testing"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	modules "github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/modules/scrape_otakudesu"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScore(t *testing.T) {
+	tests := []struct {
+		text string
+		want *float64
+	}{
+		{text: "7.12", want: ptr(7.12)},
+		{text: "7,45", want: ptr(7.45)},
+		{text: "8", want: ptr(8.0)},
+		{text: "?", want: nil},
+		{text: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseScore(tt.text))
+		})
+	}
+}
+
+func TestParseCount(t *testing.T) {
+	tests := []struct {
+		text string
+		want *int
+	}{
+		{text: "12", want: ptr(12)},
+		{text: "12 Eps", want: ptr(12)},
+		{text: "Episode 8", want: ptr(8)},
+		{text: "Unknown Eps", want: nil},
+		{text: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseCount(tt.text))
+		})
+	}
+}
+
+func TestParseDurationMinutes(t *testing.T) {
+	tests := []struct {
+		text string
+		want *int
+	}{
+		{text: "23 min. per ep.", want: ptr(23)},
+		{text: "1 hr. 47 min.", want: ptr(107)},
+		{text: "2 hr.", want: ptr(120)},
+		{text: "24 menit", want: ptr(24)},
+		{text: "Unknown", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseDurationMinutes(tt.text))
+		})
+	}
+}
+
+func TestParseReleaseDate(t *testing.T) {
+	tests := []struct {
+		text string
+		want *string
+	}{
+		{text: "Apr 05, 2025", want: ptr("2025-04-05")},
+		{text: "Jan 9, 2025", want: ptr("2025-01-09")},
+		{text: "5 Mei 2025", want: ptr("2025-05-05")},
+		{text: "17 Agustus 2024", want: ptr("2024-08-17")},
+		{text: "Okt 01, 2023", want: ptr("2023-10-01")},
+		{text: "?", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseReleaseDate(tt.text))
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	tests := []struct {
+		text string
+		want od_anime_entity.AnimeStatus
+	}{
+		{text: "Ongoing", want: od_anime_entity.StatusOngoing},
+		{text: "On-Going", want: od_anime_entity.StatusOngoing},
+		{text: "Completed", want: od_anime_entity.StatusCompleted},
+		{text: "Tamat", want: od_anime_entity.StatusCompleted},
+		{text: "Upcoming", want: od_anime_entity.StatusUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseStatus(tt.text))
+		})
+	}
+}
+
+func TestParseReleaseDay(t *testing.T) {
+	assert.Equal(t, "monday", modules.ParseReleaseDay("Senin"))
+	assert.Equal(t, "friday", modules.ParseReleaseDay(" Jum'at "))
+	assert.Equal(t, "", modules.ParseReleaseDay("Random"))
+}
+
+func TestIndexLetter(t *testing.T) {
+	tests := []struct {
+		heading string
+		title   string
+		want    string
+	}{
+		{heading: "A", title: "Ao Ashi", want: "A"},
+		{heading: "b", title: "Blue Lock", want: "B"},
+		{heading: "#", title: "86 Eighty Six", want: "#"},
+		{heading: "", title: "one piece", want: "O"},
+		{heading: "", title: ".hack//Sign", want: "#"},
+		{heading: "", title: "", want: "#"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.IndexLetter(tt.heading, tt.title))
+		})
+	}
+}
+
+func TestParseResolution(t *testing.T) {
+	tests := []struct {
+		text string
+		want *int
+	}{
+		{text: "Mp4 360p", want: ptr(360)},
+		{text: "MKV 1080p", want: ptr(1080)},
+		{text: "Mp4 HD", want: ptr(720)},
+		{text: "MKV FULLHD", want: ptr(1080)},
+		{text: "Mp4", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseResolution(tt.text))
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "Mp4 360p", want: "mp4"},
+		{text: "MKV 1080p", want: "mkv"},
+		{text: ".m3u8", want: "m3u8"},
+		{text: "720p", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseFormat(tt.text))
+		})
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		text string
+		want *int64
+	}{
+		{text: "37.4 MB", want: ptr(int64(39216742))},
+		{text: "1,2 GB", want: ptr(int64(1288490188))},
+		{text: "512KB", want: ptr(int64(524288))},
+		{text: "Unknown", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, modules.ParseSize(tt.text))
+		})
+	}
+}
+
+func TestSortStreams(t *testing.T) {
+	streams := []od_anime_entity.StreamSource{
+		{Host: "mega", Resolution: ptr(1080)},
+		{Host: "pdrain", Resolution: ptr(480), Direct: true},
+		{Host: "acefile"},
+		{Host: "pdrain", Resolution: ptr(720), Direct: true},
+		{Host: "gofile", Resolution: ptr(1080)},
+	}
+
+	hosts := []string{}
+	for _, stream := range modules.SortStreams(streams) {
+		hosts = append(hosts, stream.Host)
+	}
+	assert.Equal(t, []string{"pdrain", "pdrain", "mega", "gofile", "acefile"}, hosts)
+	assert.Equal(t, 720, *streams[0].Resolution)
+}
@@ -0,0 +1,579 @@
+package scraper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/httpclient"
+	modules "github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/modules/scrape_otakudesu"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/resolver"
+	"github.com/muhammadsaefulr/NimeStreamAPI/test/fixture/otakudesu"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newProvider(t *testing.T, baseURL string) od_anime_entity.Provider {
+	client, err := httpclient.New(httpclient.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolvers := resolver.NewRegistry(client)
+	resolvers.Register(resolver.NewPdrainResolver(), resolver.DefaultOptions)
+
+	return modules.NewProvider(baseURL, client, resolvers)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// mp4Stream builds the StreamSource expected for a scraped mp4 mirror; url is
+// the resolved URL for resolved mirrors and the scraped one otherwise.
+func mp4Stream(raw od_anime_entity.StreamSourceRaw, resolution int, size int64, url string, status od_anime_entity.ResolverStatus) od_anime_entity.StreamSource {
+	return od_anime_entity.StreamSource{
+		Resolution:     ptr(resolution),
+		Format:         "mp4",
+		SizeBytes:      ptr(size),
+		Host:           strings.ToLower(raw.Host),
+		URL:            url,
+		Direct:         status == od_anime_entity.ResolverResolved,
+		ResolverStatus: status,
+		Raw:            raw,
+	}
+}
+
+func TestOtakudesuAnimeLists(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	zatsuTabi := od_anime_entity.AnimeData{
+		Title:        "Zatsu Tabi: That's Journey",
+		URL:          base + "/anime/zatsu-tabi-sub-indo/",
+		AnimeSlug:    "zatsu-tabi-sub-indo",
+		JudulPath:    "zatsu-tabi-sub-indo",
+		ThumbnailURL: base + "/wp-content/uploads/2025/04/zatsu-tabi.jpg",
+		LatestEp:     ptr(8),
+		ReleaseDay:   "monday",
+		Raw:          od_anime_entity.AnimeDataRaw{LatestEp: "Episode 8", UpdateAnime: "Senin"},
+	}
+
+	tests := []struct {
+		name   string
+		scrape func(ctx context.Context) ([]od_anime_entity.AnimeData, error)
+		want   []od_anime_entity.AnimeData
+	}{
+		{
+			name:   "home page",
+			scrape: provider.ScrapeHomePage,
+			want: []od_anime_entity.AnimeData{
+				zatsuTabi,
+				{
+					Title:        "Dr. Stone: Science Future",
+					URL:          base + "/anime/dr-stone-s4-sub-indo/",
+					AnimeSlug:    "dr-stone-s4-sub-indo",
+					JudulPath:    "dr-stone-s4-sub-indo",
+					ThumbnailURL: base + "/wp-content/uploads/2025/01/dr-stone-s4.jpg",
+					LatestEp:     ptr(20),
+					ReleaseDay:   "thursday",
+					Raw:          od_anime_entity.AnimeDataRaw{LatestEp: "Episode 20", UpdateAnime: "Kamis"},
+				},
+			},
+		},
+		{
+			name: "ongoing anime",
+			scrape: func(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+				page, err := provider.ScrapeOngoingAnime(ctx, 1)
+				return page.Items, err
+			},
+			want: []od_anime_entity.AnimeData{zatsuTabi},
+		},
+		{
+			name: "completed anime",
+			scrape: func(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+				page, err := provider.ScrapeCompletedAnime(ctx, 2)
+				return page.Items, err
+			},
+			want: []od_anime_entity.AnimeData{
+				{
+					Title:        "Dr. Stone: Science Future",
+					URL:          base + "/anime/dr-stone-s4-sub-indo/",
+					AnimeSlug:    "dr-stone-s4-sub-indo",
+					JudulPath:    "dr-stone-s4-sub-indo",
+					ThumbnailURL: base + "/wp-content/uploads/2025/01/dr-stone-s4.jpg",
+					LatestEp:     ptr(24),
+					Raw:          od_anime_entity.AnimeDataRaw{LatestEp: "24 Episode", UpdateAnime: "8.12"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.scrape(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOtakudesuSchedule(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeSchedule(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []od_anime_entity.ScheduleDay{
+		{
+			Day: "monday",
+			Anime: []od_anime_entity.ScheduleAnime{
+				{
+					Title:        "Zatsu Tabi: That's Journey",
+					URL:          base + "/anime/zatsu-tabi-sub-indo/",
+					AnimeSlug:    "zatsu-tabi-sub-indo",
+					ThumbnailURL: base + "/wp-content/uploads/2025/04/zatsu-tabi.jpg",
+				},
+			},
+			Raw: od_anime_entity.ScheduleDayRaw{Day: "Senin"},
+		},
+		{
+			Day: "thursday",
+			Anime: []od_anime_entity.ScheduleAnime{
+				{Title: "Dr. Stone: Science Future", URL: base + "/anime/dr-stone-s4-sub-indo/", AnimeSlug: "dr-stone-s4-sub-indo"},
+			},
+			Raw: od_anime_entity.ScheduleDayRaw{Day: "Kamis"},
+		},
+		{
+			Day:   "random",
+			Anime: []od_anime_entity.ScheduleAnime{},
+			Raw:   od_anime_entity.ScheduleDayRaw{Day: "Random"},
+		},
+	}, got)
+}
+
+func TestOtakudesuAnimeIndex(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeAnimeIndex(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []od_anime_entity.AnimeIndexEntry{
+		{Title: "86 Eighty Six", URL: base + "/anime/86-eighty-six-sub-indo/", AnimeSlug: "86-eighty-six-sub-indo", Letter: "#"},
+		{Title: "Dr. Stone: Science Future", URL: base + "/anime/dr-stone-s4-sub-indo/", AnimeSlug: "dr-stone-s4-sub-indo", Letter: "D"},
+		{Title: "Zatsu Tabi: That's Journey", URL: base + "/anime/zatsu-tabi-sub-indo/", AnimeSlug: "zatsu-tabi-sub-indo", Letter: "Z"},
+	}, got)
+}
+
+func TestOtakudesuGenreList(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeGenres(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []od_anime_entity.GenreInfo{
+		{Title: "Action", URL: base + "/genres/action/", Slug: "action"},
+		{Title: "Adventure", URL: base + "/genres/adventure/", Slug: "adventure"},
+		{Title: "Slice of Life", URL: base + "/genres/slice-of-life/", Slug: "slice-of-life"},
+	}, got)
+}
+
+func TestOtakudesuGenreAnime(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeGenreAnime(context.Background(), "adventure", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, got.TotalPages)
+	assert.Equal(t, []od_anime_entity.GenreAnime{
+		{
+			Title:     "Dr. Stone: Science Future",
+			URL:       base + "/anime/dr-stone-s4-sub-indo/",
+			AnimeSlug: "dr-stone-s4-sub-indo",
+			Studio:    "TMS Entertainment",
+			Episodes:  ptr(12),
+			Rating:    ptr(8.12),
+			Raw:       od_anime_entity.GenreAnimeRaw{Episodes: "12 Eps", Rating: "8.12"},
+		},
+		{
+			Title:     "Zatsu Tabi: That's Journey",
+			URL:       base + "/anime/zatsu-tabi-sub-indo/",
+			AnimeSlug: "zatsu-tabi-sub-indo",
+			Studio:    "Studio Gokumi",
+			Raw:       od_anime_entity.GenreAnimeRaw{Episodes: "Unknown Eps"},
+		},
+	}, got.Items)
+}
+
+func TestOtakudesuSearchAnime(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	action := od_anime_entity.GenreInfo{Title: "Action", URL: base + "/genres/action/", Slug: "action"}
+
+	got, err := provider.ScrapeSearchAnime(context.Background(), "one piece", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []od_anime_entity.SearchResult{
+		{
+			Title:        "One Piece Subtitle Indonesia",
+			URL:          base + "/anime/1piece-sub-indo/",
+			AnimeSlug:    "1piece-sub-indo",
+			ThumbnailURL: base + "/wp-content/uploads/2024/01/one-piece.jpg",
+			Genres: []od_anime_entity.GenreInfo{
+				action,
+				{Title: "Adventure", URL: base + "/genres/adventure/", Slug: "adventure"},
+			},
+			Status: od_anime_entity.StatusOngoing,
+			Rating: ptr(8.73),
+			Raw:    od_anime_entity.SearchResultRaw{Status: "Ongoing", Rating: "8.73"},
+		},
+		{
+			Title:        "One Piece Film: Red Subtitle Indonesia",
+			URL:          base + "/anime/one-piece-film-red-sub-indo/",
+			AnimeSlug:    "one-piece-film-red-sub-indo",
+			ThumbnailURL: base + "/wp-content/uploads/2024/01/one-piece-film-red.jpg",
+			Genres:       []od_anime_entity.GenreInfo{action},
+			Status:       od_anime_entity.StatusCompleted,
+			Rating:       ptr(7.75),
+			Raw:          od_anime_entity.SearchResultRaw{Status: "Completed", Rating: "7.75"},
+		},
+	}, got.Items)
+}
+
+func TestOtakudesuListPaging(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	provider := newProvider(t, server.URL)
+	ctx := context.Background()
+
+	ongoing, err := provider.ScrapeOngoingAnime(ctx, 1)
+	assert.Nil(t, err)
+	completed, err := provider.ScrapeCompletedAnime(ctx, 2)
+	assert.Nil(t, err)
+	genre, err := provider.ScrapeGenreAnime(ctx, "adventure", 1)
+	assert.Nil(t, err)
+	search, err := provider.ScrapeSearchAnime(ctx, "one piece", 1)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		page       int
+		totalPages int
+		hasNext    bool
+		wantPage   int
+		wantTotal  int
+		wantNext   bool
+	}{
+		{name: "ongoing pager", page: ongoing.Page, totalPages: ongoing.TotalPages, hasNext: ongoing.HasNext, wantPage: 1, wantTotal: 2, wantNext: true},
+		{name: "last page", page: completed.Page, totalPages: completed.TotalPages, hasNext: completed.HasNext, wantPage: 2, wantTotal: 2, wantNext: false},
+		{name: "genre pager", page: genre.Page, totalPages: genre.TotalPages, hasNext: genre.HasNext, wantPage: 1, wantTotal: 3, wantNext: true},
+		{name: "search without pager", page: search.Page, totalPages: search.TotalPages, hasNext: search.HasNext, wantPage: 1, wantTotal: 1, wantNext: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantPage, tt.page)
+			assert.Equal(t, tt.wantTotal, tt.totalPages)
+			assert.Equal(t, tt.wantNext, tt.hasNext)
+		})
+	}
+}
+
+func TestOtakudesuAnimeDetail(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	detail, episodes, err := provider.ScrapeAnimeDetail(context.Background(), "zatsu-tabi-sub-indo")
+	assert.Nil(t, err)
+	assert.Equal(t, od_anime_entity.AnimeDetail{
+		AnimeSlug:       "zatsu-tabi-sub-indo",
+		ThumbnailURL:    base + "/wp-content/uploads/2025/04/zatsu-tabi.jpg",
+		Title:           "Zatsu Tabi: That's Journey",
+		Rating:          ptr(7.12),
+		Producer:        "Aniplex, Kadokawa",
+		Status:          od_anime_entity.StatusCompleted,
+		TotalEps:        ptr(12),
+		DurationMinutes: ptr(23),
+		Studio:          "Studio Gokumi",
+		ReleaseDate:     ptr("2025-04-05"),
+		Genres: []od_anime_entity.GenreInfo{
+			{Title: "Adventure", URL: base + "/genres/adventure/", Slug: "adventure"},
+			{Title: "Slice of Life", URL: base + "/genres/slice-of-life/", Slug: "slice-of-life"},
+		},
+		Synopsis:  "Chika Suzugamori, a manga artist, sets off on trips around Japan whenever inspiration runs dry.",
+		BatchSlug: "zatsu-tabi-batch-sub-indo",
+		Raw: od_anime_entity.AnimeDetailRaw{
+			Rating:      "7.12",
+			Status:      "Completed",
+			TotalEps:    "12",
+			Duration:    "23 min. per ep.",
+			ReleaseDate: "Apr 05, 2025",
+		},
+	}, detail)
+	assert.Equal(t, []od_anime_entity.AnimeEpisode{
+		{
+			Title:       "Zatsu Tabi: That's Journey Episode 2 Subtitle Indonesia",
+			VideoURL:    base + "/episode/zttj-episode-2-sub-indo/",
+			EpisodeSlug: "zttj-episode-2-sub-indo",
+		},
+		{
+			Title:       "Zatsu Tabi: That's Journey Episode 1 Subtitle Indonesia",
+			VideoURL:    base + "/episode/zttj-episode-1-sub-indo/",
+			EpisodeSlug: "zttj-episode-1-sub-indo",
+		},
+	}, episodes)
+}
+
+func TestOtakudesuAnimeBatch(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeAnimeBatch(context.Background(), "zatsu-tabi-batch-sub-indo")
+	assert.Nil(t, err)
+	assert.Equal(t, od_anime_entity.AnimeBatch{
+		Title:     "Zatsu Tabi: That's Journey Batch Subtitle Indonesia",
+		BatchSlug: "zatsu-tabi-batch-sub-indo",
+		Sources: []od_anime_entity.VideoSource{
+			{
+				Res: "Mp4 480p",
+				DataList: []od_anime_entity.AnimeEpisode{
+					{Title: "Gofile", VideoURL: "https://gofile.io/d/zttj-batch-480"},
+					{Title: "Mega", VideoURL: "https://mega.nz/folder/zttj-batch-480"},
+				},
+			},
+			{
+				Res: "Mp4 720p",
+				DataList: []od_anime_entity.AnimeEpisode{
+					{Title: "Pdrain", VideoURL: base + "/api/file/zttj-batch-720?download"},
+					{Title: "Mega", VideoURL: "https://mega.nz/folder/zttj-batch-720"},
+				},
+			},
+		},
+		Streams: []od_anime_entity.StreamSource{
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 720p", Host: "Pdrain", Size: "1.2 GB", URL: base + "/pdrain/zttj-batch-720"},
+				720, 1288490188, base+"/api/file/zttj-batch-720?download", od_anime_entity.ResolverResolved),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 720p", Host: "Mega", Size: "1.2 GB", URL: "https://mega.nz/folder/zttj-batch-720"},
+				720, 1288490188, "https://mega.nz/folder/zttj-batch-720", od_anime_entity.ResolverUnsupported),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 480p", Host: "Gofile", Size: "620 MB", URL: "https://gofile.io/d/zttj-batch-480"},
+				480, 650117120, "https://gofile.io/d/zttj-batch-480", od_anime_entity.ResolverUnsupported),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 480p", Host: "Mega", Size: "620 MB", URL: "https://mega.nz/folder/zttj-batch-480"},
+				480, 650117120, "https://mega.nz/folder/zttj-batch-480", od_anime_entity.ResolverUnsupported),
+		},
+	}, got)
+}
+
+func TestOtakudesuAnimeSourceData(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	base := server.URL
+	provider := newProvider(t, base)
+
+	got, err := provider.ScrapeAnimeSourceData(context.Background(), "zttj-episode-2-sub-indo")
+	assert.Nil(t, err)
+	assert.Equal(t, od_anime_entity.AnimeSourceData{
+		Title:           "Zatsu Tabi",
+		AnimeSlug:       "zatsu-tabi-sub-indo",
+		EpisodeSlug:     "zttj-episode-2-sub-indo",
+		ReleaseDate:     "12:00 pm",
+		CurrentEp:       "Episode 2 Subtitle Indonesia",
+		EmbedURL:        base + "/embed/zttj-2",
+		DownloadURL:     base + "/embed/zttj-2",
+		NextEpURL:       base + "/episode/zttj-episode-3-sub-indo/",
+		PrevEpisodeSlug: "zttj-episode-1-sub-indo",
+		NextEpisodeSlug: "zttj-episode-3-sub-indo",
+		Sources: []od_anime_entity.VideoSource{
+			{
+				Res: "Mp4 360p",
+				DataList: []od_anime_entity.AnimeEpisode{
+					{Title: "Pdrain", VideoURL: base + "/api/file/zttj-2-360?download"},
+					{Title: "Acefile", VideoURL: "https://acefile.co/f/1001/zttj-2-360.mp4"},
+				},
+			},
+			{
+				Res: "Mp4 720p",
+				DataList: []od_anime_entity.AnimeEpisode{
+					{Title: "Pdrain", VideoURL: base + "/api/file/zttj-2-720?download"},
+					{Title: "Mega", VideoURL: "https://mega.nz/file/zttj-2-720"},
+				},
+			},
+		},
+		Streams: []od_anime_entity.StreamSource{
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 720p", Host: "Pdrain", Size: "98.2 MB", URL: base + "/pdrain/zttj-2-720"},
+				720, 102970163, base+"/api/file/zttj-2-720?download", od_anime_entity.ResolverResolved),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 360p", Host: "Pdrain", Size: "37.4 MB", URL: base + "/pdrain/zttj-2-360"},
+				360, 39216742, base+"/api/file/zttj-2-360?download", od_anime_entity.ResolverResolved),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 720p", Host: "Mega", Size: "98.2 MB", URL: "https://mega.nz/file/zttj-2-720"},
+				720, 102970163, "https://mega.nz/file/zttj-2-720", od_anime_entity.ResolverUnsupported),
+			mp4Stream(od_anime_entity.StreamSourceRaw{Quality: "Mp4 360p", Host: "Acefile", Size: "37.4 MB", URL: "https://acefile.co/f/1001/zttj-2-360.mp4"},
+				360, 39216742, "https://acefile.co/f/1001/zttj-2-360.mp4", od_anime_entity.ResolverUnsupported),
+		},
+		StreamServers: []od_anime_entity.StreamServer{
+			{ID: "360p-0", Quality: "360p", Server: "ondesu", EmbedURL: base + "/embed/zttj-2-360-ondesu", ResolverStatus: od_anime_entity.ResolverResolved},
+			{ID: "360p-1", Quality: "360p", Server: "odstream", ResolverStatus: od_anime_entity.ResolverFailed},
+			{ID: "720p-0", Quality: "720p", Server: "ondesu", EmbedURL: base + "/embed/zttj-2-720-ondesu", ResolverStatus: od_anime_entity.ResolverResolved},
+		},
+		Episodes: []od_anime_entity.AnimeEpisode{
+			{Title: "Episode 1", VideoURL: base + "/episode/zttj-episode-1-sub-indo/", EpisodeSlug: "zttj-episode-1-sub-indo"},
+			{Title: "Episode 2", VideoURL: base + "/episode/zttj-episode-2-sub-indo/", EpisodeSlug: "zttj-episode-2-sub-indo"},
+		},
+	}, got)
+}
+
+func TestOtakudesuResolveStreamServer(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	provider := newProvider(t, server.URL)
+
+	t.Run("should resolve the server's embed URL", func(t *testing.T) {
+		got, err := provider.ResolveStreamServer(context.Background(), "zttj-episode-2-sub-indo", "720p-0")
+		assert.Nil(t, err)
+		assert.Equal(t, od_anime_entity.StreamServer{
+			ID:             "720p-0",
+			Quality:        "720p",
+			Server:         "ondesu",
+			EmbedURL:       server.URL + "/embed/zttj-2-720-ondesu",
+			ResolverStatus: od_anime_entity.ResolverResolved,
+		}, got)
+	})
+
+	t.Run("should report unknown servers", func(t *testing.T) {
+		_, err := provider.ResolveStreamServer(context.Background(), "zttj-episode-2-sub-indo", "1080p-0")
+		assert.ErrorIs(t, err, od_anime_entity.ErrServerNotFound)
+	})
+
+	t.Run("should report servers the site can't play", func(t *testing.T) {
+		_, err := provider.ResolveStreamServer(context.Background(), "zttj-episode-2-sub-indo", "360p-1")
+		assert.ErrorIs(t, err, od_anime_entity.ErrUpstreamStatus)
+	})
+}
+
+func TestOtakudesuScrapeErrors(t *testing.T) {
+	server := otakudesu.NewServer()
+	defer server.Close()
+
+	closed := otakudesu.NewServer()
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		baseURL string
+		slug    string
+		want    error
+	}{
+		{name: "missing page", baseURL: server.URL, slug: "missing-sub-indo", want: od_anime_entity.ErrNotFound},
+		{name: "layout changed", baseURL: server.URL, slug: "layout-changed", want: od_anime_entity.ErrLayoutChanged},
+		{name: "upstream unreachable", baseURL: closed.URL, slug: "zatsu-tabi-sub-indo", want: od_anime_entity.ErrUpstreamUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := newProvider(t, tt.baseURL).ScrapeAnimeDetail(context.Background(), tt.slug)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestOtakudesuEscapesSlugs(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	provider := newProvider(t, server.URL)
+	slug := "../wp-admin?s=x#y"
+	ctx := context.Background()
+
+	_, _, _ = provider.ScrapeAnimeDetail(ctx, slug)
+	_, _ = provider.ScrapeAnimeSourceData(ctx, slug)
+	_, _ = provider.ResolveStreamServer(ctx, slug, "1")
+	_, _ = provider.ScrapeAnimeBatch(ctx, slug)
+
+	escaped := "..%2Fwp-admin%3Fs=x%23y?"
+	assert.Equal(t, []string{"/anime/" + escaped, "/episode/" + escaped, "/episode/" + escaped, "/batch/" + escaped}, paths)
+}
+
+func TestOtakudesuScrapeTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := newProvider(t, server.URL).ScrapeHomePage(ctx)
+
+	assert.ErrorIs(t, err, od_anime_entity.ErrTimeout)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestOtakudesuScrapeCircuitOpen(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	breakers := httpclient.NewBreakerSet(1, time.Minute)
+	breakers.Track("Otakudesu", target.Hostname())
+
+	client, err := httpclient.New(httpclient.Config{Breakers: breakers})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := modules.NewProvider(server.URL, client, resolver.NewRegistry(client))
+
+	_, err = provider.ScrapeHomePage(context.Background())
+	assert.ErrorIs(t, err, od_anime_entity.ErrUpstreamStatus)
+
+	_, err = provider.ScrapeHomePage(context.Background())
+	assert.ErrorIs(t, err, od_anime_entity.ErrCircuitOpen)
+	assert.Equal(t, int32(1), calls.Load())
+}
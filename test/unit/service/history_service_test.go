@@ -0,0 +1,320 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/history/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/history"
+	history_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/history_service"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// memoryHistoryRepo keeps history entries in memory, one per user and
+// episode like the Postgres repository.
+type memoryHistoryRepo struct {
+	entries []model.HistoryEntry
+}
+
+func (r *memoryHistoryRepo) page(entries []model.HistoryEntry, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].WatchedAt.After(entries[j].WatchedAt) })
+
+	start := min((param.Page-1)*param.Limit, len(entries))
+	return entries[start:min(start+param.Limit, len(entries))], int64(len(entries)), nil
+}
+
+func (r *memoryHistoryRepo) GetHistory(_ context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	var entries []model.HistoryEntry
+	for _, entry := range r.entries {
+		if entry.UserID == userID && entry.Provider == provider {
+			entries = append(entries, entry)
+		}
+	}
+	return r.page(entries, param)
+}
+
+func (r *memoryHistoryRepo) GetContinueWatching(_ context.Context, userID uuid.UUID, provider string, param *request.QueryHistory) ([]model.HistoryEntry, int64, error) {
+	latest := map[string]model.HistoryEntry{}
+	for _, entry := range r.entries {
+		if entry.UserID != userID || entry.Provider != provider {
+			continue
+		}
+		if last, ok := latest[entry.AnimeSlug]; !ok || entry.WatchedAt.After(last.WatchedAt) {
+			latest[entry.AnimeSlug] = entry
+		}
+	}
+
+	var entries []model.HistoryEntry
+	for _, entry := range latest {
+		if !entry.Finished || entry.NextEpisodeSlug != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return r.page(entries, param)
+}
+
+func (r *memoryHistoryRepo) find(userID uuid.UUID, provider, episodeSlug string) int {
+	for i, entry := range r.entries {
+		if entry.UserID == userID && entry.Provider == provider && entry.EpisodeSlug == episodeSlug {
+			return i
+		}
+	}
+	return -1
+}
+
+// expireLookUp backdates when an episode was last looked up past the retry
+// delay.
+func (r *memoryHistoryRepo) expireLookUp(episodeSlug string) {
+	for i := range r.entries {
+		if r.entries[i].EpisodeSlug == episodeSlug {
+			r.entries[i].LookedUpAt = r.entries[i].LookedUpAt.Add(-2 * time.Hour)
+		}
+	}
+}
+
+func (r *memoryHistoryRepo) GetHistoryEntry(_ context.Context, userID uuid.UUID, provider, episodeSlug string) (*model.HistoryEntry, error) {
+	i := r.find(userID, provider, episodeSlug)
+	if i < 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	entry := r.entries[i]
+	return &entry, nil
+}
+
+func (r *memoryHistoryRepo) SaveHistoryEntry(_ context.Context, entry *model.HistoryEntry) error {
+	if i := r.find(entry.UserID, entry.Provider, entry.EpisodeSlug); i >= 0 {
+		entry.ID = r.entries[i].ID
+		r.entries[i] = *entry
+		return nil
+	}
+	entry.ID = uuid.New()
+	r.entries = append(r.entries, *entry)
+	return nil
+}
+
+func (r *memoryHistoryRepo) DeleteHistoryEntry(_ context.Context, userID uuid.UUID, provider, episodeSlug string) error {
+	i := r.find(userID, provider, episodeSlug)
+	if i < 0 {
+		return gorm.ErrRecordNotFound
+	}
+	r.entries = append(r.entries[:i], r.entries[i+1:]...)
+	return nil
+}
+
+func (r *memoryHistoryRepo) deleteWhere(remove func(model.HistoryEntry) bool) int64 {
+	kept := r.entries[:0]
+	for _, entry := range r.entries {
+		if !remove(entry) {
+			kept = append(kept, entry)
+		}
+	}
+	deleted := int64(len(r.entries) - len(kept))
+	r.entries = kept
+	return deleted
+}
+
+func (r *memoryHistoryRepo) DeleteUserHistoryBefore(_ context.Context, userID uuid.UUID, provider string, before time.Time) (int64, error) {
+	return r.deleteWhere(func(entry model.HistoryEntry) bool {
+		return entry.UserID == userID && entry.Provider == provider && (before.IsZero() || entry.WatchedAt.Before(before))
+	}), nil
+}
+
+func (r *memoryHistoryRepo) DeleteHistoryBefore(_ context.Context, before time.Time) (int64, error) {
+	return r.deleteWhere(func(entry model.HistoryEntry) bool { return entry.WatchedAt.Before(before) }), nil
+}
+
+// episodeAnimeService serves episode pages from a map, counting lookups.
+type episodeAnimeService struct {
+	od_service.AnimeService
+	episodes map[string]od_anime_entity.AnimeSourceData
+	err      error
+	lookups  int
+}
+
+func (s *episodeAnimeService) GetAnimeSourceVid(_ context.Context, judulEps string) (od_anime_entity.AnimeSourceData, error) {
+	s.lookups++
+	if s.err != nil {
+		return od_anime_entity.AnimeSourceData{}, s.err
+	}
+
+	source, ok := s.episodes[judulEps]
+	if !ok {
+		return od_anime_entity.AnimeSourceData{}, &od_anime_entity.ScrapeError{Op: "ScrapeAnimeSourceData", Err: od_anime_entity.ErrNotFound}
+	}
+	return source, nil
+}
+
+func newEpisodeAnimeService() *episodeAnimeService {
+	return &episodeAnimeService{episodes: map[string]od_anime_entity.AnimeSourceData{
+		"drstn-s4-episode-7-sub-indo": {Title: "Dr. Stone Season 4", AnimeSlug: "dr-stone-s4-sub-indo", CurrentEp: "Episode 7 Subtitle Indonesia", NextEpisodeSlug: "drstn-s4-episode-8-sub-indo"},
+		"drstn-s4-episode-8-sub-indo": {Title: "Dr. Stone Season 4", AnimeSlug: "dr-stone-s4-sub-indo", CurrentEp: "Episode 8 Subtitle Indonesia"},
+		"zttj-episode-2-sub-indo":     {Title: "Zatsu Tabi", AnimeSlug: "zatsu-tabi-sub-indo", CurrentEp: "Episode 2 Subtitle Indonesia", NextEpisodeSlug: "zttj-episode-3-sub-indo"},
+	}}
+}
+
+func report(t *testing.T, svc history_service.HistoryService, userID uuid.UUID, episode string, position, duration int) *model.HistoryEntry {
+	t.Helper()
+
+	entry, err := svc.ReportProgress(newFiberCtx(t), userID, &request.ReportProgress{EpisodeSlug: episode, PositionSeconds: position, DurationSeconds: duration})
+	assert.Nil(t, err)
+	return entry
+}
+
+func TestHistoryServiceReportProgress(t *testing.T) {
+	repo := &memoryHistoryRepo{}
+	anime := newEpisodeAnimeService()
+	svc := history_service.NewHistoryService("otakudesu", anime, repo, validation.Validator())
+	user := uuid.New()
+
+	t.Run("should look the episode up once", func(t *testing.T) {
+		first := report(t, svc, user, "drstn-s4-episode-7-sub-indo", 60, 1420)
+		assert.Equal(t, "dr-stone-s4-sub-indo", first.AnimeSlug)
+		assert.Equal(t, "Episode 7 Subtitle Indonesia", first.Episode)
+		assert.Equal(t, "drstn-s4-episode-8-sub-indo", first.NextEpisodeSlug)
+		assert.False(t, first.Finished)
+
+		second := report(t, svc, user, "drstn-s4-episode-7-sub-indo", 700, 1420)
+		assert.Equal(t, first.ID, second.ID)
+		assert.Equal(t, 700, second.PositionSeconds)
+		assert.Equal(t, 1, anime.lookups)
+		assert.Len(t, repo.entries, 1)
+	})
+
+	t.Run("should finish episodes watched to the credits", func(t *testing.T) {
+		entry := report(t, svc, user, "drstn-s4-episode-7-sub-indo", 1300, 1420)
+		assert.True(t, entry.Finished)
+
+		entry = report(t, svc, user, "drstn-s4-episode-7-sub-indo", 5000, 1420)
+		assert.Equal(t, 1420, entry.PositionSeconds)
+
+		// Without a duration an episode can't be finished.
+		entry = report(t, svc, user, "zttj-episode-2-sub-indo", 5000, 0)
+		assert.False(t, entry.Finished)
+	})
+
+	t.Run("should look the next episode up again once the lookup is stale", func(t *testing.T) {
+		report(t, svc, user, "drstn-s4-episode-8-sub-indo", 10, 1420)
+		lookups := anime.lookups
+
+		anime.episodes["drstn-s4-episode-8-sub-indo"] = od_anime_entity.AnimeSourceData{Title: "Dr. Stone Season 4", AnimeSlug: "dr-stone-s4-sub-indo", CurrentEp: "Episode 8 Subtitle Indonesia", NextEpisodeSlug: "drstn-s4-episode-9-sub-indo"}
+		entry := report(t, svc, user, "drstn-s4-episode-8-sub-indo", 20, 1420)
+		assert.Equal(t, lookups, anime.lookups)
+		assert.Empty(t, entry.NextEpisodeSlug)
+
+		repo.expireLookUp("drstn-s4-episode-8-sub-indo")
+		entry = report(t, svc, user, "drstn-s4-episode-8-sub-indo", 30, 1420)
+		assert.Equal(t, lookups+1, anime.lookups)
+		assert.Equal(t, "drstn-s4-episode-9-sub-indo", entry.NextEpisodeSlug)
+	})
+
+	t.Run("should keep known episodes when the source fails", func(t *testing.T) {
+		anime.episodes["drstn-s4-episode-9-sub-indo"] = od_anime_entity.AnimeSourceData{Title: "Dr. Stone Season 4", AnimeSlug: "dr-stone-s4-sub-indo", CurrentEp: "Episode 9 Subtitle Indonesia"}
+		report(t, svc, user, "drstn-s4-episode-9-sub-indo", 10, 1420)
+
+		anime.err = &od_anime_entity.ScrapeError{Op: "ScrapeAnimeSourceData", Err: od_anime_entity.ErrUpstreamUnreachable}
+		defer func() { anime.err = nil }()
+
+		repo.expireLookUp("drstn-s4-episode-9-sub-indo")
+		lookups := anime.lookups
+		entry := report(t, svc, user, "drstn-s4-episode-9-sub-indo", 30, 1420)
+		assert.Equal(t, lookups+1, anime.lookups)
+		assert.Equal(t, "dr-stone-s4-sub-indo", entry.AnimeSlug)
+		assert.Equal(t, 30, entry.PositionSeconds)
+
+		// A failed lookup waits just as long before the next try.
+		report(t, svc, user, "drstn-s4-episode-9-sub-indo", 40, 1420)
+		assert.Equal(t, lookups+1, anime.lookups)
+
+		_, err := svc.ReportProgress(newFiberCtx(t), user, &request.ReportProgress{EpisodeSlug: "zttj-episode-3-sub-indo", PositionSeconds: 1})
+		assertStatus(t, err, fiber.StatusBadGateway)
+	})
+
+	t.Run("should reject unknown episodes and bad reports", func(t *testing.T) {
+		_, err := svc.ReportProgress(newFiberCtx(t), user, &request.ReportProgress{EpisodeSlug: "missing-episode-1-sub-indo"})
+		assertStatus(t, err, fiber.StatusNotFound)
+
+		_, err = svc.ReportProgress(newFiberCtx(t), user, &request.ReportProgress{EpisodeSlug: "zttj-episode-2-sub-indo", PositionSeconds: -1})
+		assert.Error(t, err)
+
+		_, err = svc.ReportProgress(newFiberCtx(t), user, &request.ReportProgress{})
+		assert.Error(t, err)
+	})
+}
+
+func TestHistoryServiceContinueWatching(t *testing.T) {
+	repo := &memoryHistoryRepo{}
+	svc := history_service.NewHistoryService("otakudesu", newEpisodeAnimeService(), repo, validation.Validator())
+	alice, bob := uuid.New(), uuid.New()
+
+	report(t, svc, alice, "zttj-episode-2-sub-indo", 300, 1440)
+	report(t, svc, alice, "drstn-s4-episode-7-sub-indo", 1400, 1420)
+	report(t, svc, bob, "zttj-episode-2-sub-indo", 10, 1440)
+
+	t.Run("should resume the last episode of each anime", func(t *testing.T) {
+		query := &request.QueryHistory{}
+		entries, total, err := svc.GetContinueWatching(newFiberCtx(t), alice, query)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, 20, query.Limit)
+
+		assert.Equal(t, "drstn-s4-episode-7-sub-indo", entries[0].EpisodeSlug)
+		assert.True(t, entries[0].Finished)
+		assert.Equal(t, "drstn-s4-episode-8-sub-indo", entries[0].NextEpisodeSlug)
+		assert.Equal(t, "zttj-episode-2-sub-indo", entries[1].EpisodeSlug)
+		assert.Equal(t, 300, entries[1].PositionSeconds)
+	})
+
+	t.Run("should leave out anime finished up to the latest episode", func(t *testing.T) {
+		report(t, svc, alice, "drstn-s4-episode-8-sub-indo", 1420, 1420)
+
+		entries, total, err := svc.GetContinueWatching(newFiberCtx(t), alice, &request.QueryHistory{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, "zatsu-tabi-sub-indo", entries[0].AnimeSlug)
+
+		_, total, err = svc.GetHistory(newFiberCtx(t), alice, &request.QueryHistory{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+}
+
+func TestHistoryServicePrune(t *testing.T) {
+	repo := &memoryHistoryRepo{}
+	svc := history_service.NewHistoryService("otakudesu", newEpisodeAnimeService(), repo, validation.Validator())
+	alice, bob := uuid.New(), uuid.New()
+
+	report(t, svc, alice, "zttj-episode-2-sub-indo", 300, 1440)
+	report(t, svc, alice, "drstn-s4-episode-7-sub-indo", 600, 1420)
+	report(t, svc, bob, "zttj-episode-2-sub-indo", 10, 1440)
+	repo.entries[0].WatchedAt = time.Now().AddDate(0, -2, 0)
+
+	t.Run("should remove single episodes", func(t *testing.T) {
+		assert.Nil(t, svc.DeleteHistoryEntry(newFiberCtx(t), bob, "zttj-episode-2-sub-indo"))
+		assertStatus(t, svc.DeleteHistoryEntry(newFiberCtx(t), bob, "zttj-episode-2-sub-indo"), fiber.StatusNotFound)
+	})
+
+	t.Run("should clear a user's history before a time", func(t *testing.T) {
+		deleted, err := svc.PruneUserHistory(newFiberCtx(t), alice, &request.PruneHistory{Before: time.Now().AddDate(0, -1, 0)})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), deleted)
+		assert.Len(t, repo.entries, 1)
+	})
+
+	t.Run("should prune every user's old history", func(t *testing.T) {
+		report(t, svc, bob, "zttj-episode-2-sub-indo", 10, 1440)
+
+		deleted, err := svc.Prune(context.Background(), time.Now().Add(time.Minute))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), deleted)
+		assert.Empty(t, repo.entries)
+	})
+}
@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/infrastructure/cache"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingAnimeService struct {
+	od_service.AnimeService
+	calls     atomic.Int32
+	cancelled atomic.Int32
+	delay     time.Duration
+}
+
+func (s *countingAnimeService) GetHomePage(ctx context.Context) ([]od_anime_entity.AnimeData, error) {
+	s.calls.Add(1)
+	select {
+	case <-time.After(s.delay):
+		return []od_anime_entity.AnimeData{{Title: "Zatsu Tabi"}}, nil
+	case <-ctx.Done():
+		s.cancelled.Add(1)
+		return nil, ctx.Err()
+	}
+}
+
+// GetAnimeEpisode returns a detail titled after the slug it was asked for.
+func (s *countingAnimeService) GetAnimeEpisode(_ context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	s.calls.Add(1)
+	return od_anime_entity.AnimeDetail{Title: judul}, nil, nil
+}
+
+func newCacheApp(next od_service.AnimeService, ttl od_service.CacheTTL) *fiber.App {
+	return newCacheAppWithTimeout(next, ttl, time.Second)
+}
+
+func newCacheAppWithTimeout(next od_service.AnimeService, ttl od_service.CacheTTL, timeout time.Duration) *fiber.App {
+	svc := od_service.NewCachedAnimeService(next, cache.NewLRUStore(10), "test", ttl, timeout)
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		ctx := od_service.WithCacheMeta(c.UserContext())
+		if _, err := svc.GetHomePage(ctx); err != nil {
+			return err
+		}
+		setCacheHeader(c, ctx)
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/detail/:judul", func(c *fiber.Ctx) error {
+		ctx := od_service.WithCacheMeta(c.UserContext())
+		detail, _, err := svc.GetAnimeEpisode(ctx, c.Params("judul"))
+		if err != nil {
+			return err
+		}
+		setCacheHeader(c, ctx)
+		return c.SendString(detail.Title)
+	})
+
+	return app
+}
+
+func setCacheHeader(c *fiber.Ctx, ctx context.Context) {
+	if meta := od_service.CacheMetaOf(ctx); meta != nil {
+		c.Set("X-Cache", meta.Status)
+	}
+}
+
+func TestCachedAnimeService(t *testing.T) {
+	t.Run("should report MISS then HIT", func(t *testing.T) {
+		next := &countingAnimeService{}
+		app := newCacheApp(next, od_service.CacheTTL{Home: time.Minute})
+
+		res, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, "MISS", res.Header.Get("X-Cache"))
+
+		res, err = app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, "HIT", res.Header.Get("X-Cache"))
+		assert.Equal(t, int32(1), next.calls.Load())
+	})
+
+	t.Run("should serve STALE while refreshing in background", func(t *testing.T) {
+		next := &countingAnimeService{}
+		app := newCacheApp(next, od_service.CacheTTL{Home: time.Millisecond, Stale: time.Minute})
+
+		_, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Nil(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		res, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, "STALE", res.Header.Get("X-Cache"))
+		assert.Eventually(t, func() bool { return next.calls.Load() == 2 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("should revalidate STALE entries for the slug requested", func(t *testing.T) {
+		next := &countingAnimeService{}
+		app := newCacheApp(next, od_service.CacheTTL{Detail: 20 * time.Millisecond, Stale: time.Minute})
+
+		slugs := []string{"dr-stone-s4-sub-indo", "one-piece-sub-indo"}
+		for _, slug := range slugs {
+			_, err := app.Test(httptest.NewRequest(http.MethodGet, "/detail/"+slug, nil))
+			assert.Nil(t, err)
+		}
+		time.Sleep(30 * time.Millisecond)
+
+		// The background refreshes outlive their requests, whose memory is
+		// reused by the ones that follow.
+		for _, slug := range slugs {
+			res, err := app.Test(httptest.NewRequest(http.MethodGet, "/detail/"+slug, nil))
+			assert.Nil(t, err)
+			assert.Equal(t, "STALE", res.Header.Get("X-Cache"))
+		}
+		assert.Eventually(t, func() bool { return next.calls.Load() == 4 }, time.Second, 5*time.Millisecond)
+
+		for _, slug := range slugs {
+			res, err := app.Test(httptest.NewRequest(http.MethodGet, "/detail/"+slug, nil))
+			assert.Nil(t, err)
+			body, err := io.ReadAll(res.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, slug, string(body))
+		}
+	})
+
+	t.Run("should coalesce concurrent misses into one fetch", func(t *testing.T) {
+		next := &countingAnimeService{delay: 50 * time.Millisecond}
+		app := newCacheApp(next, od_service.CacheTTL{Home: time.Minute})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+				assert.Nil(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), next.calls.Load())
+	})
+
+	t.Run("should cancel shared fetch past its own deadline", func(t *testing.T) {
+		next := &countingAnimeService{delay: time.Minute}
+		app := newCacheAppWithTimeout(next, od_service.CacheTTL{Home: time.Minute}, 20*time.Millisecond)
+
+		res, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil), 5000)
+		assert.Nil(t, err)
+		assert.NotEqual(t, http.StatusOK, res.StatusCode)
+		assert.Eventually(t, func() bool { return next.cancelled.Load() == 1 }, time.Second, 5*time.Millisecond)
+	})
+}
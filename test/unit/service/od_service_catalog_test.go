@@ -0,0 +1,351 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// memoryAnimeRepo keeps the catalogue in memory. indexed plays the part of
+// the anime_index table.
+type memoryAnimeRepo struct {
+	mu      sync.Mutex
+	animes  map[string]model.Anime
+	indexed []string
+}
+
+func (r *memoryAnimeRepo) GetAllAnime(_ context.Context, provider string, param *request.QueryAnimeCatalog) ([]model.Anime, int64, error) {
+	var matched []model.Anime
+	for _, slug := range r.indexed {
+		anime, ok := r.animes[slug]
+		if ok && anime.Provider == provider && (param.Status == "" || anime.Status == param.Status) &&
+			strings.Contains(strings.ToLower(anime.Title), strings.ToLower(param.Search)) && hasGenre(anime, param.Genre) {
+			anime.Episodes = nil
+			anime.URL = "https://otakudesu.cloud/anime/" + slug + "/"
+			matched = append(matched, anime)
+		}
+	}
+
+	start := min((param.Page-1)*param.Limit, len(matched))
+	end := min(start+param.Limit, len(matched))
+	return matched[start:end], int64(len(matched)), nil
+}
+
+func hasGenre(anime model.Anime, slug string) bool {
+	for _, genre := range anime.Genres {
+		if genre.Slug == slug {
+			return true
+		}
+	}
+	return slug == ""
+}
+
+func (r *memoryAnimeRepo) GetAnimeBySlug(_ context.Context, provider, slug string) (*model.Anime, error) {
+	anime, ok := r.animes[slug]
+	if !ok || anime.Provider != provider {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &anime, nil
+}
+
+func (r *memoryAnimeRepo) UpsertAnime(_ context.Context, anime *model.Anime) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	anime.ID = anime.Slug
+	r.animes[anime.Slug] = *anime
+	return nil
+}
+
+func (r *memoryAnimeRepo) UnsyncedIndexSlugs(_ context.Context, _ string, limit int) ([]string, error) {
+	var slugs []string
+	for _, slug := range r.indexed {
+		if _, ok := r.animes[slug]; !ok && len(slugs) < limit {
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs, nil
+}
+
+func (r *memoryAnimeRepo) StaleAnimeSlugs(_ context.Context, _ string, syncedBefore time.Time, limit int) ([]string, error) {
+	var slugs []string
+	for _, slug := range r.indexed {
+		anime, ok := r.animes[slug]
+		if ok && anime.Status != "completed" && anime.SyncedAt.Before(syncedBefore) && len(slugs) < limit {
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs, nil
+}
+
+type catalogProvider struct {
+	od_anime_entity.Provider
+	mu      sync.Mutex
+	scraped []string
+}
+
+func (*catalogProvider) Name() string {
+	return "otakudesu"
+}
+
+func (p *catalogProvider) ScrapeAnimeDetail(_ context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	p.mu.Lock()
+	p.scraped = append(p.scraped, judul)
+	p.mu.Unlock()
+
+	switch judul {
+	case "dr-stone-s4-sub-indo":
+		releaseDate := "2025-01-09"
+		return od_anime_entity.AnimeDetail{
+			AnimeSlug:   judul,
+			Title:       "Dr. Stone Season 4",
+			Status:      od_anime_entity.StatusOngoing,
+			Studio:      "TMS Entertainment, TMS Entertainment,  ",
+			ReleaseDate: &releaseDate,
+			Genres: []od_anime_entity.GenreInfo{
+				{Title: "Adventure", Slug: "adventure"},
+				{Title: "Sci-Fi", Slug: "sci-fi"},
+				{Title: "Adventure", Slug: "adventure"},
+			},
+		}, []od_anime_entity.AnimeEpisode{
+			{Title: "Dr. Stone S4 Episode 2 Subtitle Indonesia", EpisodeSlug: "drstn-s4-episode-2-sub-indo"},
+			{Title: "Dr. Stone S4 Episode 1 Subtitle Indonesia", EpisodeSlug: "drstn-s4-episode-1-sub-indo"},
+			{Title: "Dr. Stone S4 Special", EpisodeSlug: "drstn-s4-special-sub-indo"},
+		}, nil
+	case "dungeon-meshi-sub-indo":
+		return od_anime_entity.AnimeDetail{AnimeSlug: judul, Title: "Dungeon Meshi", Status: od_anime_entity.StatusCompleted}, nil, nil
+	}
+	return od_anime_entity.AnimeDetail{}, nil, od_anime_entity.ErrNotFound
+}
+
+func newCatalogService(provider od_anime_entity.Provider, repo *memoryAnimeRepo) od_service.AnimeCatalogService {
+	return od_service.NewAnimeCatalogService(provider, repo, validation.Validator(), od_service.CatalogSync{
+		StaleAfter:  time.Hour,
+		MaxAge:      time.Hour,
+		Batch:       10,
+		Concurrency: 2,
+		Timeout:     time.Second,
+	})
+}
+
+func TestAnimeCatalogServiceSync(t *testing.T) {
+	repo := &memoryAnimeRepo{
+		animes:  map[string]model.Anime{},
+		indexed: []string{"dr-stone-s4-sub-indo", "dungeon-meshi-sub-indo", "gone-sub-indo"},
+	}
+	provider := &catalogProvider{}
+	svc := newCatalogService(provider, repo)
+
+	t.Run("should store every scraped title and skip failures", func(t *testing.T) {
+		synced, err := svc.Sync(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, synced)
+		assert.ElementsMatch(t, repo.indexed, provider.scraped)
+	})
+
+	t.Run("should normalize the scraped detail", func(t *testing.T) {
+		anime := repo.animes["dr-stone-s4-sub-indo"]
+		assert.Equal(t, "otakudesu", anime.Provider)
+		assert.Equal(t, []model.Studio{{Name: "TMS Entertainment"}}, anime.Studios)
+		assert.Equal(t, []model.Genre{{Slug: "adventure", Title: "Adventure"}, {Slug: "sci-fi", Title: "Sci-Fi"}}, anime.Genres)
+		assert.Equal(t, time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC), *anime.ReleaseDate)
+
+		assert.Len(t, anime.Episodes, 3)
+		assert.Equal(t, 2, *anime.Episodes[0].Number)
+		assert.Equal(t, 1, anime.Episodes[1].Position)
+		assert.Nil(t, anime.Episodes[2].Number)
+	})
+
+	t.Run("should only rescrape titles that aren't completed once stale", func(t *testing.T) {
+		anime := repo.animes["dr-stone-s4-sub-indo"]
+		anime.SyncedAt = time.Now().Add(-2 * time.Hour)
+		repo.animes[anime.Slug] = anime
+		dungeon := repo.animes["dungeon-meshi-sub-indo"]
+		dungeon.SyncedAt = time.Now().Add(-2 * time.Hour)
+		repo.animes[dungeon.Slug] = dungeon
+
+		provider.scraped = nil
+		_, err := svc.Sync(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"gone-sub-indo", "dr-stone-s4-sub-indo"}, provider.scraped)
+	})
+}
+
+func TestAnimeCatalogServiceSyncReservesStaleTitles(t *testing.T) {
+	repo := &memoryAnimeRepo{
+		animes:  map[string]model.Anime{"dr-stone-s4-sub-indo": {Provider: "otakudesu", Slug: "dr-stone-s4-sub-indo", SyncedAt: time.Now().Add(-2 * time.Hour)}},
+		indexed: []string{"dr-stone-s4-sub-indo"},
+	}
+	for i := range 20 {
+		repo.indexed = append(repo.indexed, fmt.Sprintf("gone-%d-sub-indo", i))
+	}
+	provider := &catalogProvider{}
+	svc := newCatalogService(provider, repo)
+
+	// The backfill alone would fill the batch of 10.
+	_, err := svc.Sync(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, provider.scraped, 10)
+	assert.Contains(t, provider.scraped, "dr-stone-s4-sub-indo")
+}
+
+func TestAnimeCatalogServiceSyncFailsWhenNothingSynced(t *testing.T) {
+	repo := &memoryAnimeRepo{animes: map[string]model.Anime{}, indexed: []string{"gone-sub-indo"}}
+	svc := newCatalogService(&catalogProvider{}, repo)
+
+	synced, err := svc.Sync(context.Background())
+	assert.ErrorIs(t, err, od_anime_entity.ErrNotFound)
+	assert.Zero(t, synced)
+}
+
+func TestAnimeCatalogServiceReads(t *testing.T) {
+	repo := &memoryAnimeRepo{
+		animes:  map[string]model.Anime{},
+		indexed: []string{"dr-stone-s4-sub-indo", "dungeon-meshi-sub-indo"},
+	}
+	svc := newCatalogService(&catalogProvider{}, repo)
+	_, err := svc.Sync(context.Background())
+	assert.Nil(t, err)
+
+	t.Run("should serve a stored anime like the detail endpoint", func(t *testing.T) {
+		detail, eps, err := svc.GetCatalogAnime(context.Background(), "dr-stone-s4-sub-indo")
+		assert.Nil(t, err)
+
+		assert.Equal(t, "Dr. Stone Season 4", detail.Title)
+		assert.Equal(t, "TMS Entertainment", detail.Studio)
+		assert.Equal(t, "2025-01-09", *detail.ReleaseDate)
+		assert.Equal(t, od_anime_entity.Links{"self": "/api/v1/sources/otakudesu/detail/dr-stone-s4-sub-indo"}, detail.Links)
+		assert.Equal(t, od_anime_entity.Links{"genre": "/api/v1/sources/otakudesu/genre/sci-fi"}, detail.Genres[1].Links)
+
+		assert.Len(t, eps, 3)
+		assert.Equal(t, "drstn-s4-episode-2-sub-indo", eps[0].EpisodeSlug)
+		assert.Equal(t, od_anime_entity.Links{"play": "/api/v1/sources/otakudesu/play/drstn-s4-episode-2-sub-indo"}, eps[0].Links)
+	})
+
+	t.Run("should report anime that aren't catalogued", func(t *testing.T) {
+		_, _, err := svc.GetCatalogAnime(context.Background(), "gone-sub-indo")
+		assert.ErrorIs(t, err, od_anime_entity.ErrNotFound)
+	})
+
+	t.Run("should report anime that aren't completed once synced too long ago", func(t *testing.T) {
+		for _, slug := range []string{"dr-stone-s4-sub-indo", "dungeon-meshi-sub-indo"} {
+			anime := repo.animes[slug]
+			anime.SyncedAt = time.Now().Add(-2 * time.Hour)
+			repo.animes[slug] = anime
+		}
+		defer func() {
+			anime := repo.animes["dr-stone-s4-sub-indo"]
+			anime.SyncedAt = time.Now()
+			repo.animes[anime.Slug] = anime
+		}()
+
+		_, _, err := svc.GetCatalogAnime(context.Background(), "dr-stone-s4-sub-indo")
+		assert.ErrorIs(t, err, od_anime_entity.ErrNotFound)
+
+		detail, _, err := svc.GetCatalogAnime(context.Background(), "dungeon-meshi-sub-indo")
+		assert.Nil(t, err)
+		assert.Equal(t, "Dungeon Meshi", detail.Title)
+	})
+
+	t.Run("should list a genre like the genre endpoint", func(t *testing.T) {
+		result, err := svc.GetCatalogGenre(context.Background(), "sci-fi", &request.QueryAnimeList{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), result.TotalResults)
+		assert.Equal(t, 20, result.Limit)
+		assert.Equal(t, "Dr. Stone Season 4", result.Items[0].Title)
+		assert.Equal(t, "https://otakudesu.cloud/anime/dr-stone-s4-sub-indo/", result.Items[0].URL)
+		assert.Equal(t, od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/dr-stone-s4-sub-indo"}, result.Items[0].Links)
+	})
+
+	t.Run("should search titles like the search endpoint", func(t *testing.T) {
+		result, err := svc.SearchCatalog(context.Background(), "meshi", &request.QueryAnimeList{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), result.TotalResults)
+		assert.Equal(t, od_anime_entity.StatusCompleted, result.Items[0].Status)
+	})
+
+	t.Run("should report lists with no matches", func(t *testing.T) {
+		_, err := svc.SearchCatalog(context.Background(), "one piece", &request.QueryAnimeList{})
+		assert.ErrorIs(t, err, od_anime_entity.ErrNotFound)
+	})
+
+	t.Run("should report lists until every indexed title is catalogued", func(t *testing.T) {
+		repo.indexed = append(repo.indexed, "gone-sub-indo")
+		defer func() { repo.indexed = repo.indexed[:2] }()
+
+		_, err := svc.SearchCatalog(context.Background(), "meshi", &request.QueryAnimeList{})
+		assert.ErrorIs(t, err, od_anime_entity.ErrNotFound)
+	})
+}
+
+func TestCataloguedAnimeService(t *testing.T) {
+	repo := &memoryAnimeRepo{
+		animes:  map[string]model.Anime{},
+		indexed: []string{"dr-stone-s4-sub-indo", "dungeon-meshi-sub-indo"},
+	}
+	catalog := newCatalogService(&catalogProvider{}, repo)
+	_, err := catalog.Sync(context.Background())
+	assert.Nil(t, err)
+
+	next := &scrapedAnimeService{}
+	svc := od_service.NewCataloguedAnimeService(next, catalog)
+
+	t.Run("should serve catalogued anime without scraping", func(t *testing.T) {
+		detail, _, err := svc.GetAnimeEpisode(context.Background(), "dungeon-meshi-sub-indo")
+		assert.Nil(t, err)
+		assert.Equal(t, "Dungeon Meshi", detail.Title)
+
+		result, err := svc.GetAnimeByTitle(context.Background(), "stone", &request.QueryAnimeList{})
+		assert.Nil(t, err)
+		assert.Equal(t, "Dr. Stone Season 4", result.Items[0].Title)
+		assert.Empty(t, next.calls)
+	})
+
+	t.Run("should scrape what the catalogue doesn't hold", func(t *testing.T) {
+		next.calls = nil
+
+		detail, _, err := svc.GetAnimeEpisode(context.Background(), "one-piece-sub-indo")
+		assert.Nil(t, err)
+		assert.Equal(t, "scraped", detail.Title)
+
+		_, err = svc.GetAnimeGenreList(context.Background(), "isekai", &request.QueryAnimeList{})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"GetAnimeEpisode", "GetAnimeGenreList"}, next.calls)
+	})
+
+	t.Run("should not scrape invalid queries", func(t *testing.T) {
+		next.calls = nil
+
+		_, err := svc.GetAnimeByTitle(context.Background(), "stone", &request.QueryAnimeList{Limit: 500})
+		assert.Error(t, err)
+		assert.Empty(t, next.calls)
+	})
+}
+
+// scrapedAnimeService records which reads fell through to scraping.
+type scrapedAnimeService struct {
+	od_service.AnimeService
+	calls []string
+}
+
+func (s *scrapedAnimeService) GetAnimeEpisode(_ context.Context, _ string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	s.calls = append(s.calls, "GetAnimeEpisode")
+	return od_anime_entity.AnimeDetail{Title: "scraped"}, nil, nil
+}
+
+func (s *scrapedAnimeService) GetAnimeGenreList(_ context.Context, _ string, _ *request.QueryAnimeList) (od_anime_entity.Paginated[od_anime_entity.GenreAnime], error) {
+	s.calls = append(s.calls, "GetAnimeGenreList")
+	return od_anime_entity.Paginated[od_anime_entity.GenreAnime]{}, nil
+}
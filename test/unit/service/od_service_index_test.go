@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/anime_index"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+// memoryIndexRepo keeps the anime index in memory, ordered as stored.
+type memoryIndexRepo struct {
+	rows     []model.AnimeIndex
+	replaced int
+}
+
+func (r *memoryIndexRepo) GetAnimeIndex(_ context.Context, provider string, param *request.QueryAnimeIndex) ([]model.AnimeIndex, int64, error) {
+	var matched []model.AnimeIndex
+	for _, row := range r.rows {
+		if row.Provider == provider && (param.Letter == "" || row.Letter == param.Letter) {
+			matched = append(matched, row)
+		}
+	}
+
+	start := min((param.Page-1)*param.Limit, len(matched))
+	end := min(start+param.Limit, len(matched))
+	return matched[start:end], int64(len(matched)), nil
+}
+
+func (r *memoryIndexRepo) CountAnimeIndex(_ context.Context, provider string) (int64, error) {
+	var total int64
+	for _, row := range r.rows {
+		if row.Provider == provider {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (r *memoryIndexRepo) ReplaceAnimeIndex(_ context.Context, provider string, entries []model.AnimeIndex) error {
+	r.replaced++
+	r.rows = nil
+	for _, entry := range entries {
+		entry.Provider = provider
+		entry.UpdatedAt = time.Now()
+		r.rows = append(r.rows, entry)
+	}
+	return nil
+}
+
+type indexProvider struct {
+	od_anime_entity.Provider
+	entries []od_anime_entity.AnimeIndexEntry
+}
+
+func (indexProvider) Name() string {
+	return "otakudesu"
+}
+
+func (p indexProvider) ScrapeAnimeIndex(_ context.Context) ([]od_anime_entity.AnimeIndexEntry, error) {
+	return p.entries, nil
+}
+
+func newFiberCtx(t *testing.T) *fiber.Ctx {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+	t.Cleanup(func() { app.ReleaseCtx(c) })
+	return c
+}
+
+func TestAnimeIndexService(t *testing.T) {
+	provider := indexProvider{entries: []od_anime_entity.AnimeIndexEntry{
+		{Title: "86 Eighty Six", AnimeSlug: "86-eighty-six-sub-indo", Letter: "#"},
+		{Title: "Dr. Stone", AnimeSlug: "dr-stone-s4-sub-indo", Letter: "D"},
+		{Title: "Dr. Stone", AnimeSlug: "dr-stone-s4-sub-indo", Letter: "D"},
+		{Title: "Dungeon Meshi", AnimeSlug: "dungeon-meshi-sub-indo", Letter: "D"},
+	}}
+	repo := &memoryIndexRepo{}
+	svc := od_service.NewAnimeIndexService(provider, repo, validation.Validator(), time.Second)
+
+	t.Run("should report an index that was never built", func(t *testing.T) {
+		_, err := svc.GetAnimeIndex(context.Background(), &request.QueryAnimeIndex{})
+		assert.ErrorIs(t, err, od_anime_entity.ErrIndexNotReady)
+	})
+
+	t.Run("should store each title once", func(t *testing.T) {
+		assert.Nil(t, svc.Refresh(context.Background()))
+		assert.Len(t, repo.rows, 3)
+	})
+
+	t.Run("should filter by letter and paginate", func(t *testing.T) {
+		result, err := svc.GetAnimeIndex(context.Background(), &request.QueryAnimeIndex{Letter: "D", Page: 2, Limit: 1})
+		assert.Nil(t, err)
+
+		assert.Equal(t, []od_anime_entity.AnimeIndexEntry{
+			{
+				Title:     "Dungeon Meshi",
+				AnimeSlug: "dungeon-meshi-sub-indo",
+				Letter:    "D",
+				Links:     od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/dungeon-meshi-sub-indo"},
+			},
+		}, result.Items)
+		assert.Equal(t, int64(2), result.TotalResults)
+		assert.Equal(t, int64(2), result.TotalPages)
+	})
+
+	t.Run("should return an empty page for letters without titles", func(t *testing.T) {
+		result, err := svc.GetAnimeIndex(context.Background(), &request.QueryAnimeIndex{Letter: "Q"})
+		assert.Nil(t, err)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("should reject multi-letter filters", func(t *testing.T) {
+		_, err := svc.GetAnimeIndex(context.Background(), &request.QueryAnimeIndex{Letter: "AB"})
+		assert.Error(t, err)
+	})
+}
+
+func TestAnimeIndexServiceKeepsIndexOnEmptyCrawl(t *testing.T) {
+	repo := &memoryIndexRepo{rows: []model.AnimeIndex{{Provider: "otakudesu", AnimeSlug: "dr-stone-s4-sub-indo"}}}
+	svc := od_service.NewAnimeIndexService(indexProvider{}, repo, validation.Validator(), time.Second)
+
+	err := svc.Refresh(context.Background())
+	assert.ErrorIs(t, err, od_anime_entity.ErrLayoutChanged)
+	assert.Zero(t, repo.replaced)
+	assert.Len(t, repo.rows, 1)
+}
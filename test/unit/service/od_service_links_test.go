@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubProvider struct {
+	od_anime_entity.Provider
+}
+
+func (stubProvider) Name() string {
+	return "otakudesu"
+}
+
+func (stubProvider) ScrapeAnimeDetail(_ context.Context, judul string) (od_anime_entity.AnimeDetail, []od_anime_entity.AnimeEpisode, error) {
+	return od_anime_entity.AnimeDetail{
+		AnimeSlug: judul,
+		Genres:    []od_anime_entity.GenreInfo{{Title: "Adventure", Slug: "adventure"}},
+		BatchSlug: "zatsu-tabi-batch-sub-indo",
+	}, []od_anime_entity.AnimeEpisode{
+		{Title: "Episode 1", EpisodeSlug: "zttj-episode-1-sub-indo"},
+	}, nil
+}
+
+func (stubProvider) ScrapeAnimeSourceData(_ context.Context, judulEps string) (od_anime_entity.AnimeSourceData, error) {
+	return od_anime_entity.AnimeSourceData{
+		AnimeSlug:       "zatsu-tabi-sub-indo",
+		EpisodeSlug:     judulEps,
+		NextEpisodeSlug: "zttj-episode-3-sub-indo",
+		Sources: []od_anime_entity.VideoSource{
+			{Res: "Mp4 360p", DataList: []od_anime_entity.AnimeEpisode{{Title: "Mega", VideoURL: "https://mega.nz/file/x"}}},
+		},
+		StreamServers: []od_anime_entity.StreamServer{{ID: "720p-1", Quality: "720p", Server: "ondesu"}},
+	}, nil
+}
+
+func (stubProvider) ScrapeSearchAnime(_ context.Context, _ string, page int) (od_anime_entity.ListPage[od_anime_entity.SearchResult], error) {
+	return od_anime_entity.ListPage[od_anime_entity.SearchResult]{
+		Items: []od_anime_entity.SearchResult{
+			{Title: "One Piece", AnimeSlug: "1piece-sub-indo"},
+			{Title: "Broken link"},
+		},
+		Page:       page,
+		TotalPages: 1,
+	}, nil
+}
+
+func (stubProvider) ScrapeOngoingAnime(_ context.Context, page int) (od_anime_entity.ListPage[od_anime_entity.AnimeData], error) {
+	return od_anime_entity.ListPage[od_anime_entity.AnimeData]{
+		Items:      []od_anime_entity.AnimeData{{Title: "Zatsu Tabi", AnimeSlug: "zatsu-tabi-sub-indo"}},
+		Page:       page,
+		TotalPages: 1,
+	}, nil
+}
+
+func (stubProvider) ScrapeGenres(_ context.Context) ([]od_anime_entity.GenreInfo, error) {
+	return []od_anime_entity.GenreInfo{{Title: "Action", Slug: "action"}}, nil
+}
+
+func TestAnimeServiceLinks(t *testing.T) {
+	svc := od_service.NewAnimeService(stubProvider{}, validation.Validator(), time.Second)
+
+	t.Run("should link detail, genres and episodes", func(t *testing.T) {
+		detail, episodes, err := svc.GetAnimeEpisode(context.Background(), "zatsu-tabi-sub-indo")
+		assert.Nil(t, err)
+
+		assert.Equal(t, od_anime_entity.Links{
+			"self":  "/api/v1/sources/otakudesu/detail/zatsu-tabi-sub-indo",
+			"batch": "/api/v1/sources/otakudesu/batch/zatsu-tabi-batch-sub-indo",
+		}, detail.Links)
+		assert.Equal(t, od_anime_entity.Links{"genre": "/api/v1/sources/otakudesu/genre/adventure"}, detail.Genres[0].Links)
+		assert.Equal(t, od_anime_entity.Links{"play": "/api/v1/sources/otakudesu/play/zttj-episode-1-sub-indo"}, episodes[0].Links)
+	})
+
+	t.Run("should link episode navigation and stream servers but not mirrors", func(t *testing.T) {
+		source, err := svc.GetAnimeSourceVid(context.Background(), "zttj-episode-2-sub-indo")
+		assert.Nil(t, err)
+
+		assert.Equal(t, od_anime_entity.Links{
+			"self":  "/api/v1/sources/otakudesu/play/zttj-episode-2-sub-indo",
+			"anime": "/api/v1/sources/otakudesu/detail/zatsu-tabi-sub-indo",
+			"next":  "/api/v1/sources/otakudesu/play/zttj-episode-3-sub-indo",
+		}, source.Links)
+		assert.Nil(t, source.Sources[0].DataList[0].Links)
+		assert.Equal(t, od_anime_entity.Links{"self": "/api/v1/sources/otakudesu/play/zttj-episode-2-sub-indo/server/720p-1"}, source.StreamServers[0].Links)
+	})
+
+	t.Run("should skip links for items without a slug", func(t *testing.T) {
+		results, err := svc.GetAnimeByTitle(context.Background(), "one piece", &request.QueryAnimeList{})
+		assert.Nil(t, err)
+
+		assert.Equal(t, od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/1piece-sub-indo"}, results.Items[0].Links)
+		assert.Nil(t, results.Items[1].Links)
+	})
+
+	t.Run("should link the genre catalogue", func(t *testing.T) {
+		genres, err := svc.GetGenres(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, od_anime_entity.Links{"genre": "/api/v1/sources/otakudesu/genre/action"}, genres[0].Links)
+	})
+
+	t.Run("should link ongoing anime", func(t *testing.T) {
+		results, err := svc.GetOngoingAnime(context.Background(), &request.QueryAnimeList{})
+		assert.Nil(t, err)
+
+		assert.Equal(t, od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/zatsu-tabi-sub-indo"}, results.Items[0].Links)
+	})
+}
@@ -0,0 +1,194 @@
+package service_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedProvider serves a genre listing of total anime split into upstream
+// pages of perPage, numbered "anime-1", "anime-2", ...
+type pagedProvider struct {
+	od_anime_entity.Provider
+	total   int
+	perPage int
+	err     error
+	fetched []int
+}
+
+func (p *pagedProvider) Name() string {
+	return "paged"
+}
+
+func (p *pagedProvider) ScrapeGenreAnime(_ context.Context, _ string, page int) (od_anime_entity.ListPage[od_anime_entity.GenreAnime], error) {
+	p.fetched = append(p.fetched, page)
+	if p.err != nil {
+		return od_anime_entity.ListPage[od_anime_entity.GenreAnime]{}, p.err
+	}
+
+	totalPages := (p.total + p.perPage - 1) / p.perPage
+	var items []od_anime_entity.GenreAnime
+	for i := (page-1)*p.perPage + 1; i <= min(page*p.perPage, p.total); i++ {
+		items = append(items, od_anime_entity.GenreAnime{Title: "anime-" + strconv.Itoa(i)})
+	}
+
+	return od_anime_entity.ListPage[od_anime_entity.GenreAnime]{
+		Items:      items,
+		Page:       page,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+	}, nil
+}
+
+func titles(items []od_anime_entity.GenreAnime) []string {
+	result := []string{}
+	for _, item := range items {
+		result = append(result, item.Title)
+	}
+	return result
+}
+
+func TestAnimeServicePagination(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        request.QueryAnimeList
+		wantTitles   []string
+		wantFetched  []int
+		wantPages    int64
+		wantTotal    int64
+		wantPageSize int
+	}{
+		{
+			name:         "window inside the first upstream page",
+			query:        request.QueryAnimeList{Page: 1, Limit: 3},
+			wantTitles:   []string{"anime-1", "anime-2", "anime-3"},
+			wantFetched:  []int{1},
+			wantPages:    4,
+			wantTotal:    12,
+			wantPageSize: 3,
+		},
+		{
+			name:         "window spanning upstream pages",
+			query:        request.QueryAnimeList{Page: 2, Limit: 5},
+			wantTitles:   []string{"anime-6", "anime-7", "anime-8", "anime-9", "anime-10"},
+			wantFetched:  []int{1, 2, 3},
+			wantPages:    2,
+			wantTotal:    10,
+			wantPageSize: 5,
+		},
+		{
+			name:         "last partial window",
+			query:        request.QueryAnimeList{Page: 4, Limit: 3},
+			wantTitles:   []string{"anime-10"},
+			wantFetched:  []int{1, 3},
+			wantPages:    4,
+			wantTotal:    10,
+			wantPageSize: 3,
+		},
+		{
+			name:         "window past the end",
+			query:        request.QueryAnimeList{Page: 9, Limit: 5},
+			wantTitles:   []string{},
+			wantFetched:  []int{1},
+			wantPages:    3,
+			wantTotal:    12,
+			wantPageSize: 5,
+		},
+		{
+			name:         "defaults",
+			query:        request.QueryAnimeList{},
+			wantTitles:   []string{"anime-1", "anime-2", "anime-3", "anime-4", "anime-5", "anime-6", "anime-7", "anime-8", "anime-9", "anime-10"},
+			wantFetched:  []int{1, 2, 3},
+			wantPages:    1,
+			wantTotal:    10,
+			wantPageSize: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &pagedProvider{total: 10, perPage: 4}
+			svc := od_service.NewAnimeService(provider, validation.Validator(), time.Second)
+
+			result, err := svc.GetAnimeGenreList(context.Background(), "adventure", &tt.query)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantTitles, titles(result.Items))
+			assert.Equal(t, tt.wantFetched, provider.fetched)
+			assert.Equal(t, tt.wantPages, result.TotalPages)
+			assert.Equal(t, tt.wantTotal, result.TotalResults)
+			assert.Equal(t, tt.wantPageSize, result.Limit)
+		})
+	}
+}
+
+func TestAnimeServicePaginationValidation(t *testing.T) {
+	provider := &pagedProvider{total: 10, perPage: 4}
+	svc := od_service.NewAnimeService(provider, validation.Validator(), time.Second)
+
+	_, err := svc.GetAnimeGenreList(context.Background(), "adventure", &request.QueryAnimeList{Page: 1, Limit: 500})
+
+	var validationErr validator.ValidationErrors
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Empty(t, provider.fetched)
+}
+
+func TestAnimeServiceUnknownGenre(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *pagedProvider
+	}{
+		{name: "empty listing", provider: &pagedProvider{perPage: 4}},
+		{name: "missing upstream page", provider: &pagedProvider{perPage: 4, err: &od_anime_entity.ScrapeError{Op: "ScrapeGenreAnime", Err: od_anime_entity.ErrNotFound}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := od_service.NewAnimeService(tt.provider, validation.Validator(), time.Second)
+
+			_, err := svc.GetAnimeGenreList(context.Background(), "not-a-genre", &request.QueryAnimeList{})
+			assert.ErrorIs(t, err, od_anime_entity.ErrGenreNotFound)
+		})
+	}
+}
+
+func TestAnimeServiceGenrePage(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        int
+		wantTitles  []string
+		wantFetched []int
+	}{
+		{name: "first page", page: 1, wantTitles: []string{"anime-1", "anime-2", "anime-3", "anime-4"}, wantFetched: []int{1}},
+		{name: "upstream page", page: 3, wantTitles: []string{"anime-9", "anime-10"}, wantFetched: []int{1, 3}},
+		{name: "page past the end", page: 9, wantTitles: []string{}, wantFetched: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &pagedProvider{total: 10, perPage: 4}
+			svc := od_service.NewAnimeService(provider, validation.Validator(), time.Second)
+
+			result, err := svc.GetAnimeGenrePage(context.Background(), "adventure", tt.page)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantTitles, titles(result.Items))
+			assert.Equal(t, tt.wantFetched, provider.fetched)
+			assert.Equal(t, tt.page, result.Page)
+		})
+	}
+
+	t.Run("should report unknown genre", func(t *testing.T) {
+		svc := od_service.NewAnimeService(&pagedProvider{perPage: 4}, validation.Validator(), time.Second)
+
+		_, err := svc.GetAnimeGenrePage(context.Background(), "not-a-genre", 2)
+		assert.ErrorIs(t, err, od_anime_entity.ErrGenreNotFound)
+	})
+}
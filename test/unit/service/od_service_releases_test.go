@@ -0,0 +1,198 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/release"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryReleaseRepo keeps release states and events in memory, applying the
+// same rules as the Postgres repository.
+type memoryReleaseRepo struct {
+	states map[string]model.ReleaseState
+	events []model.ReleaseEvent
+	seq    int64
+}
+
+func newMemoryReleaseRepo() *memoryReleaseRepo {
+	return &memoryReleaseRepo{states: map[string]model.ReleaseState{}}
+}
+
+func (r *memoryReleaseRepo) GetReleaseEvents(_ context.Context, provider string, param *request.QueryReleaseFeed) ([]model.ReleaseEvent, error) {
+	var events []model.ReleaseEvent
+	for _, event := range r.events {
+		if event.Provider == provider && event.Seq > param.After && event.DetectedAt.After(param.Since) {
+			events = append(events, event)
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if param.After == 0 && param.Since.IsZero() {
+			return events[i].Seq > events[j].Seq
+		}
+		return events[i].Seq < events[j].Seq
+	})
+	return events[:min(param.Limit, len(events))], nil
+}
+
+func (r *memoryReleaseRepo) GetReleaseStates(_ context.Context, provider string) ([]model.ReleaseState, error) {
+	var states []model.ReleaseState
+	for _, state := range r.states {
+		if state.Provider == provider {
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
+
+func (r *memoryReleaseRepo) RecordReleases(_ context.Context, states []model.ReleaseState, events []model.ReleaseEvent) error {
+	for _, state := range states {
+		if old, ok := r.states[state.AnimeSlug]; ok && old.LatestEp > state.LatestEp {
+			continue
+		}
+		r.states[state.AnimeSlug] = state
+	}
+
+	for _, event := range events {
+		duplicate := false
+		for _, recorded := range r.events {
+			duplicate = duplicate || (recorded.AnimeSlug == event.AnimeSlug && recorded.Episode == event.Episode)
+		}
+		if !duplicate {
+			r.seq++
+			event.ID = event.AnimeSlug + "-" + time.Now().String()
+			event.Seq = r.seq
+			r.events = append(r.events, event)
+		}
+	}
+	return nil
+}
+
+func listed(slug string, latestEp int) od_anime_entity.AnimeData {
+	return od_anime_entity.AnimeData{Title: slug, AnimeSlug: slug, URL: "https://otakudesu.cloud/anime/" + slug + "/", LatestEp: &latestEp}
+}
+
+func episodesOf(repo *memoryReleaseRepo, slug string) []int {
+	var episodes []int
+	for _, event := range repo.events {
+		if event.AnimeSlug == slug {
+			episodes = append(episodes, event.Episode)
+		}
+	}
+	sort.Ints(episodes)
+	return episodes
+}
+
+func TestReleaseFeedServiceTrack(t *testing.T) {
+	repo := newMemoryReleaseRepo()
+	svc := od_service.NewReleaseFeedService("otakudesu", repo, validation.Validator())
+	ctx := context.Background()
+
+	t.Run("should only set the baseline on the first listing", func(t *testing.T) {
+		found, err := svc.Track(ctx, []od_anime_entity.AnimeData{listed("dr-stone-s4-sub-indo", 7), listed("one-piece-sub-indo", 1120)})
+		assert.Nil(t, err)
+		assert.Zero(t, found)
+		assert.Empty(t, repo.events)
+		assert.Len(t, repo.states, 2)
+	})
+
+	t.Run("should record new episodes once", func(t *testing.T) {
+		listing := []od_anime_entity.AnimeData{listed("dr-stone-s4-sub-indo", 8), listed("one-piece-sub-indo", 1120)}
+
+		found, err := svc.Track(ctx, listing)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, found)
+
+		// The ongoing listing sees the same episode again.
+		found, err = svc.Track(ctx, listing)
+		assert.Nil(t, err)
+		assert.Zero(t, found)
+
+		assert.Equal(t, []int{8}, episodesOf(repo, "dr-stone-s4-sub-indo"))
+		assert.Equal(t, model.EventEpisodeReleased, repo.events[0].Type)
+	})
+
+	t.Run("should record first episodes of anime new to the listings", func(t *testing.T) {
+		found, err := svc.Track(ctx, []od_anime_entity.AnimeData{listed("dandadan-s2-sub-indo", 1)})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, found)
+	})
+
+	t.Run("should only set the baseline of older anime new to the listings", func(t *testing.T) {
+		found, err := svc.Track(ctx, []od_anime_entity.AnimeData{listed("kaiju-no-8-s2-sub-indo", 5)})
+		assert.Nil(t, err)
+		assert.Zero(t, found)
+		assert.Empty(t, episodesOf(repo, "kaiju-no-8-s2-sub-indo"))
+		assert.Equal(t, 5, repo.states["kaiju-no-8-s2-sub-indo"].LatestEp)
+
+		found, err = svc.Track(ctx, []od_anime_entity.AnimeData{listed("kaiju-no-8-s2-sub-indo", 6)})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, found)
+	})
+
+	t.Run("should cap the episodes recorded after a gap", func(t *testing.T) {
+		found, err := svc.Track(ctx, []od_anime_entity.AnimeData{listed("one-piece-sub-indo", 1127)})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, found)
+		assert.Equal(t, []int{1125, 1126, 1127}, episodesOf(repo, "one-piece-sub-indo"))
+	})
+
+	t.Run("should ignore older and unnumbered episodes", func(t *testing.T) {
+		unnumbered := od_anime_entity.AnimeData{AnimeSlug: "gachiakuta-sub-indo"}
+		found, err := svc.Track(ctx, []od_anime_entity.AnimeData{listed("dr-stone-s4-sub-indo", 6), unnumbered})
+		assert.Nil(t, err)
+		assert.Zero(t, found)
+		assert.Equal(t, 8, repo.states["dr-stone-s4-sub-indo"].LatestEp)
+	})
+}
+
+func TestReleaseFeedServiceGetReleases(t *testing.T) {
+	repo := newMemoryReleaseRepo()
+	now := time.Now()
+	repo.events = []model.ReleaseEvent{
+		{ID: "1", Seq: 1, Provider: "otakudesu", AnimeSlug: "dr-stone-s4-sub-indo", Episode: 7, DetectedAt: now.Add(-2 * time.Hour)},
+		{ID: "2", Seq: 2, Provider: "otakudesu", AnimeSlug: "dr-stone-s4-sub-indo", Episode: 8, DetectedAt: now.Add(-time.Hour)},
+	}
+	svc := od_service.NewReleaseFeedService("otakudesu", repo, validation.Validator())
+
+	t.Run("should return events newest first with links", func(t *testing.T) {
+		events, err := svc.GetReleases(context.Background(), &request.QueryReleaseFeed{})
+		assert.Nil(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, 8, events[0].Episode)
+		assert.Equal(t, od_anime_entity.Links{"detail": "/api/v1/sources/otakudesu/detail/dr-stone-s4-sub-indo"}, events[0].Links)
+	})
+
+	t.Run("should return events after since oldest first", func(t *testing.T) {
+		events, err := svc.GetReleases(context.Background(), &request.QueryReleaseFeed{Since: now.Add(-90 * time.Minute)})
+		assert.Nil(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, "2", events[0].ID)
+
+		events, err = svc.GetReleases(context.Background(), &request.QueryReleaseFeed{Since: now.Add(-3 * time.Hour), Limit: 1})
+		assert.Nil(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, "1", events[0].ID)
+	})
+
+	t.Run("should return events after a seq oldest first", func(t *testing.T) {
+		events, err := svc.GetReleases(context.Background(), &request.QueryReleaseFeed{After: 1})
+		assert.Nil(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, int64(2), events[0].Seq)
+	})
+
+	t.Run("should reject oversized pages", func(t *testing.T) {
+		_, err := svc.GetReleases(context.Background(), &request.QueryReleaseFeed{Limit: 500})
+		assert.Error(t, err)
+	})
+}
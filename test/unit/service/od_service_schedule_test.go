@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/anime/request"
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func (stubProvider) ScrapeSchedule(_ context.Context) ([]od_anime_entity.ScheduleDay, error) {
+	return []od_anime_entity.ScheduleDay{
+		{Day: "monday", Anime: []od_anime_entity.ScheduleAnime{{Title: "Zatsu Tabi", AnimeSlug: "zatsu-tabi-sub-indo"}}},
+		{Day: "thursday", Anime: []od_anime_entity.ScheduleAnime{{Title: "Dr. Stone", AnimeSlug: "dr-stone-s4-sub-indo"}}},
+	}, nil
+}
+
+func TestAnimeServiceSchedule(t *testing.T) {
+	svc := od_service.NewAnimeService(stubProvider{}, validation.Validator(), time.Second)
+
+	tests := []struct {
+		name     string
+		day      string
+		wantDays []string
+	}{
+		{name: "whole week", day: "", wantDays: []string{"monday", "thursday"}},
+		{name: "single day", day: "thursday", wantDays: []string{"thursday"}},
+		{name: "day without releases", day: "sunday", wantDays: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := svc.GetSchedule(context.Background(), &request.QuerySchedule{Day: tt.day})
+			assert.Nil(t, err)
+
+			days := []string{}
+			for _, day := range schedule {
+				days = append(days, day.Day)
+				assert.NotEmpty(t, day.Anime[0].Links["detail"])
+			}
+			assert.Equal(t, tt.wantDays, days)
+		})
+	}
+
+	t.Run("should reject unknown days", func(t *testing.T) {
+		_, err := svc.GetSchedule(context.Background(), &request.QuerySchedule{Day: "senin"})
+
+		var validationErr validator.ValidationErrors
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
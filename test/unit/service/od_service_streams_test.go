@@ -0,0 +1,46 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	od_anime_entity "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/entity/otakudesu_scrape"
+	od_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/otakudesu_scrape"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type streamProvider struct {
+	stubProvider
+}
+
+func (streamProvider) ScrapeAnimeSourceData(_ context.Context, judulEps string) (od_anime_entity.AnimeSourceData, error) {
+	return od_anime_entity.AnimeSourceData{
+		EpisodeSlug: judulEps,
+		Streams: []od_anime_entity.StreamSource{
+			{Host: "pdrain", URL: "https://pixeldrain.com/api/file/x", Direct: true},
+			{Host: "mega", URL: "https://mega.nz/file/x"},
+		},
+	}, nil
+}
+
+type prefixLinker struct{}
+
+func (prefixLinker) Link(upstreamURL string) string {
+	return "/api/v1/stream/" + upstreamURL
+}
+
+func TestStreamLinkedAnimeService(t *testing.T) {
+	svc := od_service.NewStreamLinkedAnimeService(
+		od_service.NewAnimeService(streamProvider{}, validation.Validator(), time.Second),
+		prefixLinker{},
+	)
+
+	source, err := svc.GetAnimeSourceVid(context.Background(), "zttj-episode-2-sub-indo")
+	assert.Nil(t, err)
+
+	assert.Equal(t, od_anime_entity.Links{"stream": "/api/v1/stream/https://pixeldrain.com/api/file/x"}, source.Streams[0].Links)
+	assert.Nil(t, source.Streams[1].Links)
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/dto/watchlist/request"
+	model "github.com/muhammadsaefulr/NimeStreamAPI/internal/domain/model/watchlist"
+	watchlist_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/watchlist_service"
+	"github.com/muhammadsaefulr/NimeStreamAPI/internal/shared/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// memoryWatchlistRepo keeps watchlist items in memory, with the unique
+// constraint and not-found errors of the Postgres repository.
+type memoryWatchlistRepo struct {
+	items []*model.WatchlistItem
+}
+
+func (r *memoryWatchlistRepo) find(userID uuid.UUID, provider, slug string) int {
+	for i, item := range r.items {
+		if item.UserID == userID && item.Provider == provider && item.AnimeSlug == slug {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *memoryWatchlistRepo) GetWatchlist(_ context.Context, userID uuid.UUID, param *request.QueryWatchlist) ([]model.WatchlistItem, int64, error) {
+	var items []model.WatchlistItem
+	for _, item := range r.items {
+		if item.UserID == userID && (param.Status == "" || item.Status == param.Status) {
+			items = append(items, *item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].UpdatedAt.After(items[j].UpdatedAt) })
+
+	total := int64(len(items))
+	start := min((param.Page-1)*param.Limit, len(items))
+	return items[start:min(start+param.Limit, len(items))], total, nil
+}
+
+func (r *memoryWatchlistRepo) GetWatchlistItem(_ context.Context, userID uuid.UUID, provider, slug string) (*model.WatchlistItem, error) {
+	i := r.find(userID, provider, slug)
+	if i < 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	item := *r.items[i]
+	return &item, nil
+}
+
+func (r *memoryWatchlistRepo) CreateWatchlistItem(_ context.Context, item *model.WatchlistItem) error {
+	if r.find(item.UserID, item.Provider, item.AnimeSlug) >= 0 {
+		return gorm.ErrDuplicatedKey
+	}
+	item.ID = uuid.New()
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = time.Now()
+	saved := *item
+	r.items = append(r.items, &saved)
+	return nil
+}
+
+func (r *memoryWatchlistRepo) UpdateWatchlistItem(_ context.Context, item *model.WatchlistItem) error {
+	i := r.find(item.UserID, item.Provider, item.AnimeSlug)
+	if i < 0 {
+		return gorm.ErrRecordNotFound
+	}
+	item.UpdatedAt = time.Now()
+	saved := *item
+	r.items[i] = &saved
+	return nil
+}
+
+func (r *memoryWatchlistRepo) DeleteWatchlistItem(_ context.Context, userID uuid.UUID, provider, slug string) error {
+	i := r.find(userID, provider, slug)
+	if i < 0 {
+		return gorm.ErrRecordNotFound
+	}
+	r.items = append(r.items[:i], r.items[i+1:]...)
+	return nil
+}
+
+func assertStatus(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var fiberErr *fiber.Error
+	if assert.ErrorAs(t, err, &fiberErr) {
+		assert.Equal(t, code, fiberErr.Code)
+	}
+}
+
+func TestWatchlistService(t *testing.T) {
+	repo := &memoryWatchlistRepo{}
+	svc := watchlist_service.NewWatchlistService("otakudesu", repo, validation.Validator())
+	alice, bob := uuid.New(), uuid.New()
+	score := 8
+
+	t.Run("should add anime as plan to watch by default", func(t *testing.T) {
+		item, err := svc.AddWatchlistItem(newFiberCtx(t), alice, &request.CreateWatchlist{AnimeSlug: "dr-stone-s4-sub-indo", Score: &score})
+		assert.Nil(t, err)
+		assert.Equal(t, model.StatusPlanToWatch, item.Status)
+		assert.Equal(t, "otakudesu", item.Provider)
+		assert.Equal(t, 8, *item.Score)
+
+		_, err = svc.AddWatchlistItem(newFiberCtx(t), alice, &request.CreateWatchlist{AnimeSlug: "one-piece-sub-indo", Status: model.StatusWatching})
+		assert.Nil(t, err)
+	})
+
+	t.Run("should reject anime already listed", func(t *testing.T) {
+		_, err := svc.AddWatchlistItem(newFiberCtx(t), alice, &request.CreateWatchlist{AnimeSlug: "dr-stone-s4-sub-indo"})
+		assertStatus(t, err, fiber.StatusConflict)
+
+		// Other users keep their own list.
+		_, err = svc.AddWatchlistItem(newFiberCtx(t), bob, &request.CreateWatchlist{AnimeSlug: "dr-stone-s4-sub-indo"})
+		assert.Nil(t, err)
+	})
+
+	t.Run("should reject invalid items", func(t *testing.T) {
+		tooHigh := 11
+		_, err := svc.AddWatchlistItem(newFiberCtx(t), alice, &request.CreateWatchlist{AnimeSlug: "dandadan-s2-sub-indo", Score: &tooHigh})
+		assert.Error(t, err)
+
+		_, err = svc.AddWatchlistItem(newFiberCtx(t), alice, &request.CreateWatchlist{AnimeSlug: "dandadan-s2-sub-indo", Status: "rewatching"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should list a user's anime by status", func(t *testing.T) {
+		query := &request.QueryWatchlist{}
+		items, total, err := svc.GetWatchlist(newFiberCtx(t), alice, query)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Len(t, items, 2)
+		assert.Equal(t, 1, query.Page)
+		assert.Equal(t, 20, query.Limit)
+
+		items, total, err = svc.GetWatchlist(newFiberCtx(t), alice, &request.QueryWatchlist{Status: model.StatusWatching})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, "one-piece-sub-indo", items[0].AnimeSlug)
+	})
+
+	t.Run("should update only the fields sent", func(t *testing.T) {
+		status := model.StatusCompleted
+		item, err := svc.UpdateWatchlistItem(newFiberCtx(t), alice, "dr-stone-s4-sub-indo", &request.UpdateWatchlist{Status: &status})
+		assert.Nil(t, err)
+		assert.Equal(t, model.StatusCompleted, item.Status)
+		assert.Equal(t, 8, *item.Score)
+
+		unset := 0
+		item, err = svc.UpdateWatchlistItem(newFiberCtx(t), alice, "dr-stone-s4-sub-indo", &request.UpdateWatchlist{Score: &unset})
+		assert.Nil(t, err)
+		assert.Nil(t, item.Score)
+		assert.Equal(t, model.StatusCompleted, item.Status)
+
+		_, err = svc.UpdateWatchlistItem(newFiberCtx(t), alice, "dandadan-s2-sub-indo", &request.UpdateWatchlist{Status: &status})
+		assertStatus(t, err, fiber.StatusNotFound)
+	})
+
+	t.Run("should remove anime", func(t *testing.T) {
+		assert.Nil(t, svc.DeleteWatchlistItem(newFiberCtx(t), alice, "dr-stone-s4-sub-indo"))
+
+		_, err := svc.GetWatchlistItem(newFiberCtx(t), alice, "dr-stone-s4-sub-indo")
+		assertStatus(t, err, fiber.StatusNotFound)
+
+		assertStatus(t, svc.DeleteWatchlistItem(newFiberCtx(t), alice, "dr-stone-s4-sub-indo"), fiber.StatusNotFound)
+
+		_, err = svc.GetWatchlistItem(newFiberCtx(t), bob, "dr-stone-s4-sub-indo")
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,201 @@
+package stream_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	stream_service "github.com/muhammadsaefulr/NimeStreamAPI/internal/service/stream_service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+const video = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// newUpstream serves a video with Range support and an HLS playlist whose
+// segments and key live next to it.
+func newUpstream(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/video.mp4", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "video.mp4", time.Time{}, strings.NewReader(video))
+	})
+	mux.HandleFunc("/hls/index.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+		_, _ = io.WriteString(w, "#EXTM3U\n#EXT-X-KEY:METHOD=AES-128,URI=\"key.bin\"\n#EXTINF:4.0,\nseg-0.ts\n#EXTINF:4.0,\n/hls/seg-1.ts\n#EXT-X-ENDLIST\n")
+	})
+	mux.HandleFunc("/hls/seg-0.ts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "segment-0")
+	})
+	mux.HandleFunc("/gone.mp4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newStreamApp serves svc like the stream controller does, taking the viewer
+// from the X-Viewer header.
+func newStreamApp(svc stream_service.StreamService) *fiber.App {
+	app := fiber.New()
+	app.Get("/api/v1/stream/:token", func(c *fiber.Ctx) error {
+		stream, err := svc.Open(c, c.Params("token"), c.Get("X-Viewer"))
+		switch {
+		case errors.Is(err, stream_service.ErrStreamNotFound):
+			return c.SendStatus(fiber.StatusNotFound)
+		case errors.Is(err, stream_service.ErrStreamExpired):
+			return c.SendStatus(fiber.StatusGone)
+		case errors.Is(err, stream_service.ErrTooManyStreams):
+			return c.SendStatus(fiber.StatusTooManyRequests)
+		case err != nil:
+			return c.SendStatus(fiber.StatusBadGateway)
+		}
+
+		for name := range stream.Header {
+			c.Set(name, stream.Header.Get(name))
+		}
+		return c.Status(stream.StatusCode).SendStream(stream.Body, int(stream.Size))
+	})
+	return app
+}
+
+func newService(opts stream_service.Options) stream_service.StreamService {
+	opts.Secret = "test-secret"
+	opts.BasePath = "/api/v1/stream"
+	if opts.TokenTTL == 0 {
+		opts.TokenTTL = time.Hour
+	}
+	return stream_service.NewStreamService(&http.Client{}, opts)
+}
+
+func get(t *testing.T, app *fiber.App, link string, header map[string]string) (*http.Response, string) {
+	req := httptest.NewRequest(http.MethodGet, link, nil)
+	for name, value := range header {
+		req.Header.Set(name, value)
+	}
+
+	res, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	return res, string(body)
+}
+
+func TestStreamRange(t *testing.T) {
+	upstream := newUpstream(t)
+	svc := newService(stream_service.Options{})
+	app := newStreamApp(svc)
+	link := svc.Link(upstream.URL + "/video.mp4")
+
+	t.Run("should stream the whole video", func(t *testing.T) {
+		res, body := get(t, app, link, nil)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, video, body)
+		assert.Equal(t, "bytes", res.Header.Get("Accept-Ranges"))
+	})
+
+	t.Run("should pass on range requests", func(t *testing.T) {
+		res, body := get(t, app, link, map[string]string{"Range": "bytes=10-15"})
+		assert.Equal(t, http.StatusPartialContent, res.StatusCode)
+		assert.Equal(t, "abcdef", body)
+		assert.Equal(t, "bytes 10-15/36", res.Header.Get("Content-Range"))
+		assert.Equal(t, "6", res.Header.Get("Content-Length"))
+	})
+
+	t.Run("should pass on unsatisfiable ranges", func(t *testing.T) {
+		res, _ := get(t, app, link, map[string]string{"Range": "bytes=100-"})
+		assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, res.StatusCode)
+	})
+}
+
+func TestStreamPlaylistRewrite(t *testing.T) {
+	upstream := newUpstream(t)
+	svc := newService(stream_service.Options{MaxPerViewer: 1, IdleWindow: time.Minute})
+	app := newStreamApp(svc)
+	viewer := map[string]string{"X-Viewer": "user:1"}
+
+	res, body := get(t, app, svc.Link(upstream.URL+"/hls/index.m3u8"), viewer)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/vnd.apple.mpegurl", res.Header.Get("Content-Type"))
+	assert.NotContains(t, body, upstream.URL)
+
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	assert.Len(t, lines, 7)
+	assert.Equal(t, "#EXTM3U", lines[0])
+	assert.Regexp(t, `^#EXT-X-KEY:METHOD=AES-128,URI="/api/v1/stream/[^"]+"$`, lines[1])
+	assert.Regexp(t, `^/api/v1/stream/\S+$`, lines[3])
+	assert.Regexp(t, `^/api/v1/stream/\S+$`, lines[5])
+
+	// Segments count as the playlist's stream, so they pass the limit of one.
+	res, body = get(t, app, lines[3], viewer)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "segment-0", body)
+}
+
+func TestStreamViewerLimit(t *testing.T) {
+	upstream := newUpstream(t)
+	svc := newService(stream_service.Options{MaxPerViewer: 1, IdleWindow: time.Minute})
+	app := newStreamApp(svc)
+
+	first := svc.Link(upstream.URL + "/video.mp4")
+	second := svc.Link(upstream.URL + "/hls/index.m3u8")
+
+	res, _ := get(t, app, first, map[string]string{"X-Viewer": "user:1"})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res, _ = get(t, app, second, map[string]string{"X-Viewer": "user:1"})
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+
+	res, _ = get(t, app, first, map[string]string{"X-Viewer": "user:1", "Range": "bytes=0-3"})
+	assert.Equal(t, http.StatusPartialContent, res.StatusCode)
+
+	res, _ = get(t, app, second, map[string]string{"X-Viewer": "user:2"})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestStreamIdleStreamsFreeTheirSlot(t *testing.T) {
+	upstream := newUpstream(t)
+	svc := newService(stream_service.Options{MaxPerViewer: 1, IdleWindow: 20 * time.Millisecond})
+	app := newStreamApp(svc)
+
+	res, _ := get(t, app, svc.Link(upstream.URL+"/video.mp4"), map[string]string{"X-Viewer": "user:1"})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	time.Sleep(30 * time.Millisecond)
+	res, _ = get(t, app, svc.Link(upstream.URL+"/hls/index.m3u8"), map[string]string{"X-Viewer": "user:1"})
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestStreamTokens(t *testing.T) {
+	upstream := newUpstream(t)
+	svc := newService(stream_service.Options{})
+	app := newStreamApp(svc)
+	link := svc.Link(upstream.URL + "/video.mp4")
+
+	tests := []struct {
+		name string
+		link string
+		want int
+	}{
+		{name: "garbage", link: "/api/v1/stream/not-a-token", want: http.StatusNotFound},
+		{name: "tampered", link: link[:len(link)-2] + "xx", want: http.StatusNotFound},
+		{name: "other secret", link: stream_service.NewStreamService(&http.Client{}, stream_service.Options{Secret: "other", BasePath: "/api/v1/stream", TokenTTL: time.Hour}).Link(upstream.URL + "/video.mp4"), want: http.StatusNotFound},
+		{name: "expired", link: newService(stream_service.Options{TokenTTL: -time.Minute}).Link(upstream.URL + "/video.mp4"), want: http.StatusGone},
+		{name: "upstream refuses", link: svc.Link(upstream.URL + "/gone.mp4"), want: http.StatusBadGateway},
+		{name: "not http", link: svc.Link("file:///etc/passwd"), want: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, _ := get(t, app, tt.link, nil)
+			assert.Equal(t, tt.want, res.StatusCode)
+		})
+	}
+}